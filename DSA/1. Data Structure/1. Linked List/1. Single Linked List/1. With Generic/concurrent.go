@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sync"
+
+	"generic/linkedlist"
+)
+
+/*
+stress runs insert from several goroutines at the same time, each one in its own region of values,
+then deletes half of the values again
+The race tests and the benchmarks comparing both lists are in linkedlist/sync_test.go
+*/
+func stress(insert func(int), remove func(int) error) {
+	const workers, perWorker = 8, 500
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values := rand.Perm(perWorker)
+			for _, v := range values {
+				insert(w*perWorker + v)
+			}
+			for _, v := range values[:perWorker/2] {
+				remove(w*perWorker + v)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func concurrentDemo() {
+	shared := linkedlist.NewSync[int]()
+	stress(func(v int) { shared.InsertInSortedList(v) }, shared.DeleteByValue)
+	sorted, _ := shared.IsSorted()
+	fmt.Printf("SyncList : %d values, sorted %v \n", shared.Len(), sorted)
+
+	coupled := linkedlist.NewHandOverHand[int]()
+	stress(func(v int) { coupled.Insert(v) }, coupled.Delete)
+	fmt.Printf("HandOverHandList : %d values \n", coupled.Len())
+
+	shared.With(func(list *linkedlist.LinkedList[int]) {
+		list.Rotate(list.Len() / 2)
+	})
+	first, _ := shared.PopFront()
+	fmt.Println("First After Rotate :", first)
+}
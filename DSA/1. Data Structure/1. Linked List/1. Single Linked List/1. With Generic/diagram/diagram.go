@@ -0,0 +1,89 @@
+/*
+Package diagram lays out the ASCII drawings of the linked list packages
+The singly, doubly and circular lists each collect their cells, arrows and pointer markers
+and hand them to Chain, which writes the row of nodes with the markers above it and the return arrow of a cycle below it
+It lives in the generic module because every list module already depends on it,
+a Go internal package cannot be shared between modules
+*/
+package diagram
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+Chain lays out cells in a row, arrows[i] joins cell i-1 to cell i and the markers of each cell are written above it
+end is appended after the last cell and empty is the whole drawing when there are no cells
+A loop of 0 or more draws a return arrow from the last cell back to the cell with that index instead of end
+*/
+func Chain(cells, arrows []string, markers map[int][]string, end, empty string, loop int) string {
+	if len(cells) == 0 {
+		return empty + "\n"
+	}
+
+	var chain strings.Builder
+	starts := make([]int, len(cells))
+	column := 0
+	for i, cell := range cells {
+		if i > 0 {
+			chain.WriteString(arrows[i])
+			column += utf8.RuneCountInString(arrows[i])
+		}
+		starts[i] = column
+		chain.WriteString(cell)
+		column += utf8.RuneCountInString(cell)
+	}
+
+	var drawing strings.Builder
+	if len(markers) > 0 {
+		var names, pointers strings.Builder
+		namesWidth, pointersWidth := 0, 0
+		for i := range cells {
+			if len(markers[i]) == 0 {
+				continue
+			}
+
+			name := strings.Join(markers[i], ",")
+			namesWidth += pad(&names, starts[i]-namesWidth)
+			names.WriteString(name)
+			namesWidth += utf8.RuneCountInString(name)
+
+			pointersWidth += pad(&pointers, starts[i]+1-pointersWidth)
+			pointers.WriteString("v")
+			pointersWidth++
+		}
+		drawing.WriteString(names.String() + "\n")
+		drawing.WriteString(pointers.String() + "\n")
+	}
+
+	if loop < 0 {
+		drawing.WriteString(chain.String() + end + "\n")
+		return drawing.String()
+	}
+
+	drawing.WriteString(chain.String() + " -+\n")
+	drawing.WriteString(strings.Repeat(" ", starts[loop]+1) + "^" + strings.Repeat("-", column-starts[loop]) + "+\n")
+	return drawing.String()
+}
+
+// pad writes n spaces to builder, at least one if n is not positive so neighbouring names never touch, and returns how many it wrote
+func pad(builder *strings.Builder, n int) int {
+	if n < 1 && builder.Len() > 0 {
+		n = 1
+	}
+	if n < 0 {
+		n = 0
+	}
+	builder.WriteString(strings.Repeat(" ", n))
+	return n
+}
+
+// Problems lists problems one per line after a "!" so they stand out under a drawing
+func Problems(problems []string) string {
+	var text strings.Builder
+	for _, problem := range problems {
+		text.WriteString("! " + problem + "\n")
+	}
+	return text.String()
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"generic/linkedlist"
+)
+
+// encodingDemo round trips lists through JSON, gob and the streaming binary format
+func encodingDemo() {
+	orders := linkedlist.FromSeq(slices.Values([]Order{{ID: "A1", Amount: 120}, {ID: "A2", Amount: 75.5}}))
+	encoded, _ := json.Marshal(orders)
+	fmt.Println("JSON :", string(encoded))
+
+	var decoded linkedlist.LinkedList[Order]
+	if err := json.Unmarshal(encoded, &decoded); err == nil {
+		last, _ := decoded.Back()
+		fmt.Println("Decoded :", decoded.Len(), "orders, last", last.ID)
+	}
+
+	var network bytes.Buffer
+	gob.NewEncoder(&network).Encode(orders)
+	var received linkedlist.LinkedList[Order]
+	if err := gob.NewDecoder(&network).Decode(&received); err == nil {
+		fmt.Println("Gob :", received.Len(), "orders")
+	}
+
+	const n = 2_000_000
+	readings := linkedlist.New[float64]()
+	for i := 0; i < n; i++ {
+		readings.PushBack(float64(i) / 10)
+	}
+
+	var file bytes.Buffer
+	start := time.Now()
+	written, _ := readings.WriteTo(&file)
+	loaded := linkedlist.New[float64]()
+	read, err := loaded.ReadFrom(&file)
+	if err == nil {
+		fmt.Printf("Binary : %d values, %d bytes written, %d bytes read, %v \n", loaded.Len(), written, read, time.Since(start))
+	}
+
+	names := linkedlist.New[string]()
+	if _, err := names.WriteTo(&file); err != nil {
+		fmt.Println("WriteTo :", err)
+	}
+}
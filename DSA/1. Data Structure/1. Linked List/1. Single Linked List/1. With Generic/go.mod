@@ -0,0 +1,3 @@
+module generic
+
+go 1.23.4
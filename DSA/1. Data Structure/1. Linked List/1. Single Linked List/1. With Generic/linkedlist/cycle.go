@@ -0,0 +1,103 @@
+package linkedlist
+
+/*
+HasCycle reports whether following Next from the Head ever revisits a node
+It uses Floyd's tortoise and hare: a slow pointer moves one step and a fast pointer two steps
+If there is a cycle the fast pointer eventually catches the slow one, otherwise it reaches nil
+It runs in O(n) time and O(1) space
+*/
+func (list *LinkedList[T]) HasCycle() bool {
+	_, ok := list.meetingPoint()
+	return ok
+}
+
+/*
+CycleStart returns the first node of the cycle and true, or nil and false if there is no cycle
+After the tortoise and hare meet, a pointer from the Head and a pointer from the meeting point
+move one step at a time and meet exactly at the start of the cycle
+*/
+func (list *LinkedList[T]) CycleStart() (*Node[T], bool) {
+	meet, ok := list.meetingPoint()
+	if !ok {
+		return nil, false
+	}
+
+	current := list.Head
+	for current != meet {
+		current = current.Next
+		meet = meet.Next
+	}
+
+	return current, true
+}
+
+/*
+CycleLength returns the number of nodes in the cycle, or 0 if there is no cycle
+It uses Brent's algorithm: the tortoise teleports to the hare every time the step count reaches a power of two
+The number of steps the hare takes after the last teleport until they meet is the cycle length
+*/
+func (list *LinkedList[T]) CycleLength() int {
+	if list.Head == nil {
+		return 0
+	}
+
+	power, length := 1, 1
+	tortoise := list.Head
+	hare := list.Head.Next
+	for hare != tortoise {
+		if hare == nil {
+			return 0
+		}
+
+		if power == length {
+			tortoise = hare
+			power *= 2
+			length = 0
+		}
+		hare = hare.Next
+		length++
+	}
+
+	return length
+}
+
+/*
+BreakCycle removes the cycle by cutting the link that points back to the start of the cycle
+The node holding that link becomes the Tail and the cached size is recounted
+It returns false if there was no cycle to break
+*/
+func (list *LinkedList[T]) BreakCycle() bool {
+	start, ok := list.CycleStart()
+	if !ok {
+		return false
+	}
+
+	last := start
+	for last.Next != start {
+		last = last.Next
+	}
+	last.Next = nil
+	list.Tail = last
+
+	list.size = 0
+	for current := list.Head; current != nil; current = current.Next {
+		list.size++
+	}
+
+	return true
+}
+
+// meetingPoint runs Floyd's tortoise and hare and returns the node where they meet, if any
+func (list *LinkedList[T]) meetingPoint() (*Node[T], bool) {
+	slow := list.Head
+	fast := list.Head
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			return slow, true
+		}
+	}
+
+	return nil, false
+}
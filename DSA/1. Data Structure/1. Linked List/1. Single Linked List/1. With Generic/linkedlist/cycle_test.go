@@ -0,0 +1,81 @@
+package linkedlist_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"generic/linkedlist"
+)
+
+// cyclic returns a list of values whose Tail links back to the node at index start, or an acyclic list for start -1
+func cyclic(values []int, start int) *linkedlist.LinkedList[int] {
+	list := linkedlist.New[int]()
+	for _, v := range values {
+		list.PushBack(v)
+	}
+	if start >= 0 {
+		node := list.Head
+		for range start {
+			node = node.Next
+		}
+		list.Tail.Next = node
+	}
+	return list
+}
+
+func TestCycle(t *testing.T) {
+	values := []int{10, 20, 30, 40, 50}
+	for start := -1; start < len(values); start++ {
+		list := cyclic(values, start)
+
+		node, ok := list.CycleStart()
+		if ok != (start >= 0) || list.HasCycle() != ok {
+			t.Fatalf("start %d: HasCycle %t, CycleStart %t", start, list.HasCycle(), ok)
+		}
+		if !ok {
+			if list.CycleLength() != 0 || list.BreakCycle() {
+				t.Fatalf("acyclic list: CycleLength %d, BreakCycle reported a cycle", list.CycleLength())
+			}
+			continue
+		}
+
+		if node.Data != values[start] {
+			t.Fatalf("start %d: CycleStart at %d, want %d", start, node.Data, values[start])
+		}
+		if got, want := list.CycleLength(), len(values)-start; got != want {
+			t.Fatalf("start %d: CycleLength %d, want %d", start, got, want)
+		}
+		if err := list.Print(); !errors.Is(err, linkedlist.ErrCycle) {
+			t.Fatalf("start %d: Print returned %v, want %v", start, err, linkedlist.ErrCycle)
+		}
+		if _, err := list.Length(); !errors.Is(err, linkedlist.ErrCycle) {
+			t.Fatalf("start %d: Length returned %v, want %v", start, err, linkedlist.ErrCycle)
+		}
+
+		if !list.BreakCycle() || list.HasCycle() {
+			t.Fatalf("start %d: BreakCycle left a cycle", start)
+		}
+		if got := slices.Collect(list.Values()); !slices.Equal(got, values) || list.Len() != len(values) {
+			t.Fatalf("start %d: after BreakCycle got %v with Len %d, want %v", start, got, list.Len(), values)
+		}
+		if list.Tail.Data != values[len(values)-1] || list.Tail.Next != nil {
+			t.Fatalf("start %d: after BreakCycle Tail is %d", start, list.Tail.Data)
+		}
+	}
+}
+
+// TestCycleIterators checks that the iterators stop instead of looping forever on a cyclic list
+func TestCycleIterators(t *testing.T) {
+	list := cyclic([]int{1, 2, 3}, 1)
+
+	for i, v := range list.All() {
+		t.Fatalf("All yielded %d: %d on a cyclic list", i, v)
+	}
+	for v := range list.Values() {
+		t.Fatalf("Values yielded %d on a cyclic list", v)
+	}
+	for v := range list.Backward() {
+		t.Fatalf("Backward yielded %d on a cyclic list", v)
+	}
+}
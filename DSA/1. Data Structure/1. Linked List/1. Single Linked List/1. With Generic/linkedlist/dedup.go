@@ -0,0 +1,49 @@
+package linkedlist
+
+/*
+Dedup removes adjacent duplicate values from the linked list
+On a sorted list every repeated value is adjacent, so this removes all duplicates in O(n) without extra memory
+The first node of each run of equal values is kept
+It returns the number of removed nodes
+*/
+func (list *LinkedList[T]) Dedup() int {
+	removed := 0
+	current := list.Head
+	for current != nil && current.Next != nil {
+		if current.Next.Data == current.Data {
+			list.removeAfter(current)
+			removed++
+			continue
+		}
+		current = current.Next
+	}
+
+	return removed
+}
+
+/*
+DedupAll removes every repeated value from an unsorted linked list
+It remembers the values already seen in a hash set and keeps only their first occurrence
+It runs in O(n) time and O(n) extra memory
+It returns the number of removed nodes
+*/
+func (list *LinkedList[T]) DedupAll() int {
+	if list.Head == nil {
+		return 0
+	}
+
+	removed := 0
+	seen := map[T]struct{}{list.Head.Data: {}}
+	current := list.Head
+	for current.Next != nil {
+		if _, ok := seen[current.Next.Data]; ok {
+			list.removeAfter(current)
+			removed++
+			continue
+		}
+		seen[current.Next.Data] = struct{}{}
+		current = current.Next
+	}
+
+	return removed
+}
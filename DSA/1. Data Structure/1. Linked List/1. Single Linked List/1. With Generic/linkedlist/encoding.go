@@ -0,0 +1,249 @@
+package linkedlist
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+/*
+MarshalJSON encodes the values as a JSON array, from Head to Tail
+Compare is a function and is not part of the encoding
+A list with a cycle cannot be encoded and returns ErrCycle
+*/
+func (list *LinkedList[T]) MarshalJSON() ([]byte, error) {
+	if list.HasCycle() {
+		return nil, ErrCycle
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteByte('[')
+	for current := list.Head; current != nil; current = current.Next {
+		data, err := json.Marshal(current.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.Write(data)
+		if current.Next != nil {
+			buffer.WriteByte(',')
+		}
+	}
+	buffer.WriteByte(']')
+	return buffer.Bytes(), nil
+}
+
+/*
+UnmarshalJSON replaces the values with the ones in a JSON array, a JSON null gives an empty list
+The elements are decoded one by one straight into new nodes, Compare is kept
+On error the list is left unchanged
+*/
+func (list *LinkedList[T]) UnmarshalJSON(data []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	decoded := LinkedList[T]{Compare: list.Compare, pool: list.pool}
+	if token == nil {
+		*list = decoded
+		return nil
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("%w: expected a JSON array", ErrInvalidEncoding)
+	}
+
+	for decoder.More() {
+		var value T
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+		decoded.PushBack(value)
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return err
+	}
+
+	*list = decoded
+	return nil
+}
+
+/*
+MarshalBinary encodes the values with encoding/gob, it also makes the list work with gob.Encoder directly
+A list with a cycle cannot be encoded and returns ErrCycle
+*/
+func (list *LinkedList[T]) MarshalBinary() ([]byte, error) {
+	if list.HasCycle() {
+		return nil, ErrCycle
+	}
+
+	values := make([]T, 0, list.size)
+	for current := list.Head; current != nil; current = current.Next {
+		values = append(values, current.Data)
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(values); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalBinary replaces the values with the ones encoded by MarshalBinary, Compare is kept
+func (list *LinkedList[T]) UnmarshalBinary(data []byte) error {
+	var values []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values); err != nil {
+		return err
+	}
+
+	decoded := LinkedList[T]{Compare: list.Compare, pool: list.pool}
+	for _, value := range values {
+		decoded.PushBack(value)
+	}
+
+	*list = decoded
+	return nil
+}
+
+// chunkSize is the number of values WriteTo and ReadFrom move through their buffer at a time
+const chunkSize = 4096
+
+/*
+WriteTo writes the list in a compact length-prefixed binary format and returns the number of bytes written
+The format is the number of values (uint64), the size of one value in bytes (uint8) and then every value
+in little endian order, it only works for fixed size types such as the numeric types, int and uint
+are written as 64 bit values. Other types return ErrUnsupportedType
+The values are encoded in chunks straight from the nodes, no slice of the whole list is built
+*/
+func (list *LinkedList[T]) WriteTo(w io.Writer) (int64, error) {
+	size := fixedSize[T]()
+	if size <= 0 || size > 255 {
+		return 0, ErrUnsupportedType
+	}
+	if list.HasCycle() {
+		return 0, ErrCycle
+	}
+
+	var written int64
+	header := make([]byte, 9)
+	binary.LittleEndian.PutUint64(header, uint64(list.size))
+	header[8] = byte(size)
+	n, err := w.Write(header)
+	written += int64(n)
+	if err != nil {
+		return written, err
+	}
+
+	buffer := make([]byte, 0, chunkSize*size)
+	for current := list.Head; current != nil; current = current.Next {
+		buffer = buffer[:len(buffer)+size]
+		if err := putValue(buffer[len(buffer)-size:], current.Data); err != nil {
+			return written, err
+		}
+
+		if len(buffer) == cap(buffer) || current.Next == nil {
+			n, err := w.Write(buffer)
+			written += int64(n)
+			if err != nil {
+				return written, err
+			}
+			buffer = buffer[:0]
+		}
+	}
+	return written, nil
+}
+
+/*
+ReadFrom replaces the values with the ones written by WriteTo and returns the number of bytes read
+It reads exactly the bytes of one list, in chunks, and links every value into a new node as it goes,
+so a list of millions of values is loaded without an intermediate slice
+It returns ErrUnsupportedType for types WriteTo cannot write and ErrInvalidEncoding when the value size
+does not match T, on any error the list is left unchanged
+*/
+func (list *LinkedList[T]) ReadFrom(r io.Reader) (int64, error) {
+	size := fixedSize[T]()
+	if size <= 0 || size > 255 {
+		return 0, ErrUnsupportedType
+	}
+
+	var read int64
+	header := make([]byte, 9)
+	n, err := io.ReadFull(r, header)
+	read += int64(n)
+	if err != nil {
+		return read, err
+	}
+
+	count := binary.LittleEndian.Uint64(header)
+	if int(header[8]) != size {
+		return read, fmt.Errorf("%w: value size is %d bytes, want %d", ErrInvalidEncoding, header[8], size)
+	}
+
+	decoded := LinkedList[T]{Compare: list.Compare, pool: list.pool}
+	buffer := make([]byte, chunkSize*size)
+	for remaining := count; remaining > 0; {
+		chunk := min(remaining, chunkSize)
+		n, err := io.ReadFull(r, buffer[:int(chunk)*size])
+		read += int64(n)
+		if err != nil {
+			return read, err
+		}
+
+		for offset := 0; offset < n; offset += size {
+			value, err := getValue[T](buffer[offset : offset+size])
+			if err != nil {
+				return read, err
+			}
+			decoded.PushBack(value)
+		}
+		remaining -= chunk
+	}
+
+	*list = decoded
+	return read, nil
+}
+
+// fixedSize returns the number of bytes WriteTo uses for one value of type T, or -1 if T has no fixed size
+func fixedSize[T any]() int {
+	var zero T
+	switch any(zero).(type) {
+	case int, uint:
+		return 8
+	}
+	return binary.Size(zero)
+}
+
+// putValue encodes value into buffer in little endian order, int and uint are widened to 64 bits
+func putValue[T any](buffer []byte, value T) error {
+	switch v := any(value).(type) {
+	case int:
+		binary.LittleEndian.PutUint64(buffer, uint64(v))
+	case uint:
+		binary.LittleEndian.PutUint64(buffer, uint64(v))
+	default:
+		_, err := binary.Encode(buffer, binary.LittleEndian, value)
+		return err
+	}
+	return nil
+}
+
+// getValue decodes a value written by putValue
+func getValue[T any](buffer []byte) (T, error) {
+	var value T
+	switch v := any(&value).(type) {
+	case *int:
+		*v = int(int64(binary.LittleEndian.Uint64(buffer)))
+	case *uint:
+		*v = uint(binary.LittleEndian.Uint64(buffer))
+	default:
+		_, err := binary.Decode(buffer, binary.LittleEndian, &value)
+		return value, err
+	}
+	return value, nil
+}
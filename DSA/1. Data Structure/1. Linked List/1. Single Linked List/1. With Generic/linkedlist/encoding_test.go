@@ -0,0 +1,178 @@
+package linkedlist_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+
+	"generic/linkedlist"
+)
+
+// lengths are the list lengths the round trips run at, 5000 values span more than one chunk of WriteTo
+var lengths = []int{0, 1, 3, 5000}
+
+// filled returns a list of ordered values 0, -1, 2, -3, ... so the sign of every other value is checked too
+func filled(n int) *linkedlist.LinkedList[int] {
+	list := linkedlist.New[int]()
+	for i := range n {
+		if i%2 == 1 {
+			i = -i
+		}
+		list.PushBack(i)
+	}
+	return list
+}
+
+// checkDecoded fails t if got does not hold the values of want, or lost its Compare function or its Tail
+func checkDecoded(t *testing.T, name string, got, want *linkedlist.LinkedList[int]) {
+	t.Helper()
+
+	if values := slices.Collect(got.Values()); !slices.Equal(values, slices.Collect(want.Values())) || got.Len() != want.Len() {
+		t.Fatalf("%s: got %d values %v, want %d", name, got.Len(), values, want.Len())
+	}
+	if got.Len() > 0 && (got.Tail == nil || got.Tail.Data != want.Tail.Data) {
+		t.Fatalf("%s: Tail is not the last value", name)
+	}
+	if got.Compare == nil {
+		t.Fatalf("%s: the decoded list lost its Compare function", name)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	for _, n := range lengths {
+		list := filled(n)
+		data, err := json.Marshal(list)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded := linkedlist.New[int]()
+		decoded.PushBack(99)
+		if err := json.Unmarshal(data, decoded); err != nil {
+			t.Fatalf("n = %d: %v", n, err)
+		}
+		checkDecoded(t, "JSON", decoded, list)
+	}
+
+	if data, err := json.Marshal(linkedlist.New[int]()); err != nil || string(data) != "[]" {
+		t.Fatalf("empty list: got %s, %v, want []", data, err)
+	}
+
+	decoded := filled(3)
+	if err := json.Unmarshal([]byte("null"), decoded); err != nil || decoded.Len() != 0 || decoded.Head != nil {
+		t.Fatalf("null: got %d values, %v, want an empty list", decoded.Len(), err)
+	}
+}
+
+func TestJSONInvalid(t *testing.T) {
+	for _, data := range []string{`{"a": 1}`, `[1, "two"]`, `[1, 2`, `7`, ``} {
+		list := filled(3)
+		if err := list.UnmarshalJSON([]byte(data)); err == nil {
+			t.Fatalf("%q: decoded without an error", data)
+		}
+		checkDecoded(t, data, list, filled(3))
+	}
+
+	cyclic := filled(3)
+	cyclic.Tail.Next = cyclic.Head
+	if _, err := json.Marshal(cyclic); !errors.Is(err, linkedlist.ErrCycle) {
+		t.Fatalf("cyclic list: got %v, want %v", err, linkedlist.ErrCycle)
+	}
+}
+
+func TestGob(t *testing.T) {
+	for _, n := range lengths {
+		list := filled(n)
+		var network bytes.Buffer
+		if err := gob.NewEncoder(&network).Encode(list); err != nil {
+			t.Fatal(err)
+		}
+
+		decoded := linkedlist.New[int]()
+		if err := gob.NewDecoder(&network).Decode(decoded); err != nil {
+			t.Fatalf("n = %d: %v", n, err)
+		}
+		checkDecoded(t, "gob", decoded, list)
+	}
+
+	list := filled(3)
+	if err := list.UnmarshalBinary([]byte{1, 2, 3}); err == nil {
+		t.Fatal("gob: decoded garbage without an error")
+	}
+	checkDecoded(t, "gob garbage", list, filled(3))
+}
+
+func TestWriteToReadFrom(t *testing.T) {
+	for _, n := range lengths {
+		list := filled(n)
+		var file bytes.Buffer
+		written, err := list.WriteTo(&file)
+		if err != nil || written != int64(9+8*n) || int64(file.Len()) != written {
+			t.Fatalf("n = %d: WriteTo wrote %d bytes into %d, %v, want %d", n, written, file.Len(), err, 9+8*n)
+		}
+
+		// A second list after the first checks that ReadFrom stops at the end of its own bytes
+		filled(2).WriteTo(&file)
+		decoded := linkedlist.New[int]()
+		read, err := decoded.ReadFrom(&file)
+		if err != nil || read != written {
+			t.Fatalf("n = %d: ReadFrom read %d bytes, %v, want %d", n, read, err, written)
+		}
+		checkDecoded(t, "ReadFrom", decoded, list)
+		if file.Len() != 9+2*8 {
+			t.Fatalf("n = %d: ReadFrom left %d bytes, want the %d of the next list", n, file.Len(), 9+2*8)
+		}
+	}
+
+	floats := linkedlist.New[float32]()
+	floats.PushBack(1.5)
+	floats.PushBack(-2)
+	var file bytes.Buffer
+	floats.WriteTo(&file)
+	decoded := linkedlist.New[float32]()
+	if _, err := decoded.ReadFrom(&file); err != nil || !slices.Equal(slices.Collect(decoded.Values()), []float32{1.5, -2}) {
+		t.Fatalf("float32: got %v, %v", slices.Collect(decoded.Values()), err)
+	}
+
+	if _, err := linkedlist.New[string]().WriteTo(&file); !errors.Is(err, linkedlist.ErrUnsupportedType) {
+		t.Fatalf("WriteTo of strings: got %v, want %v", err, linkedlist.ErrUnsupportedType)
+	}
+	if _, err := linkedlist.New[string]().ReadFrom(&file); !errors.Is(err, linkedlist.ErrUnsupportedType) {
+		t.Fatalf("ReadFrom of strings: got %v, want %v", err, linkedlist.ErrUnsupportedType)
+	}
+}
+
+// header returns the 9 byte header of WriteTo for count values of size bytes
+func header(count uint64, size byte) []byte {
+	return append(binary.LittleEndian.AppendUint64(nil, count), size)
+}
+
+func TestReadFromInvalid(t *testing.T) {
+	var valid bytes.Buffer
+	filled(3).WriteTo(&valid)
+
+	inputs := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"Empty", nil, io.EOF},
+		{"ShortHeader", header(3, 8)[:5], io.ErrUnexpectedEOF},
+		{"WrongValueSize", append(header(3, 4), make([]byte, 12)...), linkedlist.ErrInvalidEncoding},
+		{"TruncatedChunk", valid.Bytes()[:valid.Len()-3], io.ErrUnexpectedEOF},
+		{"OversizedLength", append(header(1<<62, 8), make([]byte, 64)...), io.ErrUnexpectedEOF},
+	}
+
+	for _, input := range inputs {
+		list := filled(2)
+		if _, err := list.ReadFrom(bytes.NewReader(input.data)); !errors.Is(err, input.want) {
+			t.Fatalf("%s: got %v, want %v", input.name, err, input.want)
+		}
+		checkDecoded(t, input.name, list, filled(2))
+	}
+}
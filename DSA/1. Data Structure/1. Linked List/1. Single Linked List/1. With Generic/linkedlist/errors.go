@@ -0,0 +1,18 @@
+package linkedlist
+
+import "errors"
+
+/*
+Sentinel errors returned by the LinkedList operations
+Callers can compare against them with errors.Is to handle each failure path
+*/
+var (
+	ErrNotFound        = errors.New("linkedlist: no record found")
+	ErrIndexOutOfRange = errors.New("linkedlist: index out of range")
+	ErrEmpty           = errors.New("linkedlist: list is empty")
+	ErrNoComparator    = errors.New("linkedlist: list has no compare function")
+	ErrCycle           = errors.New("linkedlist: list contains a cycle")
+	ErrSameList        = errors.New("linkedlist: cannot merge a list with itself")
+	ErrUnsupportedType = errors.New("linkedlist: type has no fixed size binary encoding")
+	ErrInvalidEncoding = errors.New("linkedlist: invalid encoding")
+)
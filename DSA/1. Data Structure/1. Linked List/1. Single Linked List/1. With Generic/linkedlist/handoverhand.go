@@ -0,0 +1,149 @@
+package linkedlist
+
+import (
+	"cmp"
+	"iter"
+	"sync"
+	"sync/atomic"
+)
+
+// lockedNode is a node of HandOverHandList, its mutex guards its Next link
+type lockedNode[T comparable] struct {
+	mu   sync.Mutex
+	data T
+	next *lockedNode[T]
+}
+
+/*
+HandOverHandList is a sorted linked list that is safe to share between goroutines, with one mutex per node
+A goroutine walking the list holds at most two locks at a time: it locks the next node before it unlocks the
+previous one (hand-over-hand or lock coupling), so nobody can unlink the node it stands on
+Goroutines working in different regions of the list only meet on the first few nodes, instead of waiting
+on one lock for the whole list like SyncList
+The price is a lock and an unlock on every step of every walk, so for cheap comparisons SyncList is often faster
+It keeps duplicates, an equal value is inserted after the existing ones
+The zero value is an empty list without a compare function, like a LinkedList without Compare its Insert
+returns ErrNoComparator, so build it with NewHandOverHand or NewHandOverHandFunc
+*/
+type HandOverHandList[T comparable] struct {
+	head    lockedNode[T]
+	compare func(a, b T) int
+	size    atomic.Int64
+}
+
+// NewHandOverHand returns an empty hand-over-hand list of an ordered type that uses cmp.Compare
+func NewHandOverHand[T cmp.Ordered]() *HandOverHandList[T] {
+	return &HandOverHandList[T]{compare: cmp.Compare[T]}
+}
+
+// NewHandOverHandFunc returns an empty hand-over-hand list that is kept sorted with the given compare function, a nil compare makes Insert fail
+func NewHandOverHandFunc[T comparable](compare func(a, b T) int) *HandOverHandList[T] {
+	return &HandOverHandList[T]{compare: compare}
+}
+
+/*
+find walks the list until stop reports true for the next node, or the end is reached
+It returns the previous and the next node locked, next is nil at the end of the list
+The caller must unlock both
+*/
+func (list *HandOverHandList[T]) find(stop func(data T) bool) (prev, next *lockedNode[T]) {
+	prev = &list.head
+	prev.mu.Lock()
+	next = prev.next
+	if next != nil {
+		next.mu.Lock()
+	}
+
+	for next != nil && !stop(next.data) {
+		prev.mu.Unlock()
+		prev = next
+		next = next.next
+		if next != nil {
+			next.mu.Lock()
+		}
+	}
+	return prev, next
+}
+
+// unlock releases the two locks returned by find
+func unlock[T comparable](prev, next *lockedNode[T]) {
+	if next != nil {
+		next.mu.Unlock()
+	}
+	prev.mu.Unlock()
+}
+
+// Insert adds data after every value that is smaller or equal, keeping the list sorted, or returns ErrNoComparator
+func (list *HandOverHandList[T]) Insert(data T) error {
+	if list.compare == nil {
+		return ErrNoComparator
+	}
+
+	prev, next := list.find(func(current T) bool {
+		return list.compare(current, data) > 0
+	})
+	defer unlock(prev, next)
+
+	prev.next = &lockedNode[T]{data: data, next: next}
+	list.size.Add(1)
+	return nil
+}
+
+/*
+Delete removes the first occurrence of data, or returns ErrNotFound
+The walk goes past values that compare equal but are not equal to data and stops at the first larger value
+*/
+func (list *HandOverHandList[T]) Delete(data T) error {
+	prev, next := list.find(func(current T) bool {
+		return current == data || list.compare != nil && list.compare(current, data) > 0
+	})
+	defer unlock(prev, next)
+
+	if next == nil || next.data != data {
+		return ErrNotFound
+	}
+
+	prev.next = next.next
+	list.size.Add(-1)
+	return nil
+}
+
+// Contains reports whether data is in the list, like Delete the walk stops at the first larger value
+func (list *HandOverHandList[T]) Contains(data T) bool {
+	prev, next := list.find(func(current T) bool {
+		return current == data || list.compare != nil && list.compare(current, data) > 0
+	})
+	defer unlock(prev, next)
+
+	return next != nil && next.data == data
+}
+
+// Len returns the number of values in the list, under concurrent use it is only a snapshot
+func (list *HandOverHandList[T]) Len() int {
+	return int(list.size.Load())
+}
+
+/*
+Snapshot returns a copy of the values in sorted order
+It walks with lock coupling like every other operation, so writers behind it can go on while it reads ahead
+*/
+func (list *HandOverHandList[T]) Snapshot() []T {
+	values := make([]T, 0, list.Len())
+	prev, next := list.find(func(current T) bool {
+		values = append(values, current)
+		return false
+	})
+	unlock(prev, next)
+	return values
+}
+
+// Values returns an iterator over a Snapshot of the values, so the loop body may call back into the list
+func (list *HandOverHandList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, data := range list.Snapshot() {
+			if !yield(data) {
+				return
+			}
+		}
+	}
+}
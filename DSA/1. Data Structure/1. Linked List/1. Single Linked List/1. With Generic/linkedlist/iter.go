@@ -0,0 +1,89 @@
+package linkedlist
+
+import (
+	"cmp"
+	"iter"
+)
+
+/*
+All returns an iterator over the positions and values of the linked list, from Head to Tail
+It can be used as: for i, v := range list.All()
+If the nodes form a cycle it yields nothing instead of looping forever, use HasCycle to tell that apart from an empty list
+*/
+func (list *LinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		if list.HasCycle() {
+			return
+		}
+
+		index := 0
+		for current := list.Head; current != nil; current = current.Next {
+			if !yield(index, current.Data) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+/*
+Values returns an iterator over the values of the linked list, from Head to Tail
+Like All it yields nothing if the nodes form a cycle
+*/
+func (list *LinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if list.HasCycle() {
+			return
+		}
+
+		for current := list.Head; current != nil; current = current.Next {
+			if !yield(current.Data) {
+				return
+			}
+		}
+	}
+}
+
+/*
+Backward returns an iterator over the values of the linked list, from Tail to Head
+A singly linked list cannot walk backwards, so it first collects the node pointers into a slice
+and then ranges over that slice in reverse
+Unlike PrintReverseWithRecursion it does not grow the call stack, so it is safe for very long lists
+Like All it yields nothing if the nodes form a cycle, instead of collecting node pointers until memory runs out
+*/
+func (list *LinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if list.HasCycle() {
+			return
+		}
+
+		nodes := make([]*Node[T], 0, list.size)
+		for current := list.Head; current != nil; current = current.Next {
+			nodes = append(nodes, current)
+		}
+
+		for i := len(nodes) - 1; i >= 0; i-- {
+			if !yield(nodes[i].Data) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq builds a linked list without a Compare function from the values of seq, in order
+func FromSeq[T comparable](seq iter.Seq[T]) *LinkedList[T] {
+	list := &LinkedList[T]{}
+	for v := range seq {
+		list.PushBack(v)
+	}
+	return list
+}
+
+// Collect builds a linked list of an ordered type from the values of seq, in order, like New it uses cmp.Compare
+func Collect[T cmp.Ordered](seq iter.Seq[T]) *LinkedList[T] {
+	list := New[T]()
+	for v := range seq {
+		list.PushBack(v)
+	}
+	return list
+}
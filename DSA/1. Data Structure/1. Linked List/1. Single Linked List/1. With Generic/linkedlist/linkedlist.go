@@ -0,0 +1,622 @@
+/*
+Package linkedlist provides a generic singly linked list
+Every operation that can fail returns one of the sentinel errors (ErrNotFound, ErrIndexOutOfRange, ErrEmpty)
+instead of printing to stdout, so the list can be imported and its failure paths handled by the caller
+*/
+package linkedlist
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// Node represents a single node in the linked list
+type Node[T comparable] struct {
+	Data T
+	Next *Node[T]
+}
+
+/*
+LinkedList represents a linked list
+Any comparable type can be stored, equality is used by the value based operations
+Compare is optional and only needed by the ordered operations (InsertInSortedList, FindMax, FindMin)
+It must return a negative number when a < b, zero when a == b and a positive number when a > b
+Tail and size are kept in sync by every operation, so appending and reading the length are O(1)
+Nodes are allocated one by one unless a NodePool is attached with WithNodePool
+*/
+type LinkedList[T comparable] struct {
+	Head    *Node[T]
+	Tail    *Node[T]
+	Compare func(a, b T) int
+	size    int
+	pool    *NodePool[T]
+}
+
+// New returns an empty list of an ordered type that uses cmp.Compare for the ordered operations
+func New[T cmp.Ordered]() *LinkedList[T] {
+	return &LinkedList[T]{Compare: cmp.Compare[T]}
+}
+
+// NewFunc returns an empty list that uses the given compare function for the ordered operations
+func NewFunc[T comparable](compare func(a, b T) int) *LinkedList[T] {
+	return &LinkedList[T]{Compare: compare}
+}
+
+/*
+insertAfter links a new node holding data right after prev and returns it
+A nil prev inserts the node at the front of the list
+It is the single place where Tail and size are updated on insertion
+*/
+func (list *LinkedList[T]) insertAfter(prev *Node[T], data T) *Node[T] {
+	node := list.pool.get(data)
+	if prev == nil {
+		node.Next = list.Head
+		list.Head = node
+	} else {
+		node.Next = prev.Next
+		prev.Next = node
+	}
+
+	if node.Next == nil {
+		list.Tail = node
+	}
+	list.size++
+	return node
+}
+
+/*
+removeAfter unlinks the node right after prev and returns its data
+A nil prev removes the Head of the list
+It is the single place where Tail and size are updated on deletion, the node goes back to the pool if there is one
+*/
+func (list *LinkedList[T]) removeAfter(prev *Node[T]) T {
+	var node *Node[T]
+	if prev == nil {
+		node = list.Head
+		list.Head = node.Next
+	} else {
+		node = prev.Next
+		prev.Next = node.Next
+	}
+
+	if list.Tail == node {
+		list.Tail = prev
+	}
+	list.size--
+
+	data := node.Data
+	list.pool.put(node)
+	return data
+}
+
+/*
+Print prints the linked list
+It starts from the head and prints the data of each node
+It continues until it reaches the end of the list (Next is nil)
+If the nodes form a cycle nothing is printed and ErrCycle is returned instead of looping forever
+*/
+func (list *LinkedList[T]) Print() error {
+	if list.HasCycle() {
+		return ErrCycle
+	}
+
+	current := list.Head
+	for current != nil {
+		fmt.Println("Data is :", current.Data)
+		current = current.Next
+	}
+	return nil
+}
+
+/*
+InsertAtBack inserts a new node at the end of the linked list
+It takes the data to be inserted as an argument
+It creates a new node with the given data and appends it after the Tail
+If the list is empty the new node becomes both Head and Tail
+No traversal is needed, so the insertion is O(1)
+*/
+func (list *LinkedList[T]) InsertAtBack(data T) {
+	list.insertAfter(list.Tail, data)
+}
+
+// PushBack appends data to the end of the list in O(1)
+func (list *LinkedList[T]) PushBack(data T) {
+	list.insertAfter(list.Tail, data)
+}
+
+/*
+InsertAtFront inserts a new node at the front of the linked list
+It takes the data to be inserted as an argument
+It creates a new node with the given data and inserts it at the front of the list
+The current Head becomes the Next of the new node and the new node becomes the Head
+*/
+func (list *LinkedList[T]) InsertAtFront(data T) {
+	list.insertAfter(nil, data)
+}
+
+// PopFront removes the Head of the list in O(1) and returns its data, or ErrEmpty
+func (list *LinkedList[T]) PopFront() (T, error) {
+	if list.Head == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	return list.removeAfter(nil), nil
+}
+
+// Back returns the data of the Tail in O(1), or ErrEmpty
+func (list *LinkedList[T]) Back() (T, error) {
+	if list.Tail == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	return list.Tail.Data, nil
+}
+
+// Len returns the cached number of nodes in O(1)
+func (list *LinkedList[T]) Len() int {
+	return list.size
+}
+
+/*
+InsertAfterValue inserts a new node after a given value in the linked list
+It takes the value after which the new node is to be inserted and the data to be inserted as arguments
+It creates a new node with the given data and inserts it after the node with the given value
+It iterates through the list to find the node with the given value
+If the list is empty, it returns ErrEmpty
+If the node is found, it inserts the new node after it
+If the node is not found, it returns ErrNotFound
+*/
+func (list *LinkedList[T]) InsertAfterValue(afterValue, data T) error {
+	if list.Head == nil {
+		return ErrEmpty
+	}
+
+	current := list.Head
+
+	for current != nil && current.Data != afterValue {
+		current = current.Next
+	}
+
+	if current == nil {
+		return ErrNotFound
+	}
+
+	list.insertAfter(current, data)
+	return nil
+}
+
+/*
+InsertBeforeValue inserts a new node before a given value in the linked list
+It takes the value before which the new node is to be inserted and the data to be inserted as arguments
+It creates a new node with the given data and inserts it before the node with the given value
+It iterates through the list to find the node before the node with the given value
+If the list is empty, it returns ErrEmpty
+If the node is found, it inserts the new node before it
+If the node is not found, it returns ErrNotFound
+*/
+func (list *LinkedList[T]) InsertBeforeValue(beforeValue, data T) error {
+	if list.Head == nil {
+		return ErrEmpty
+	}
+
+	if list.Head.Data == beforeValue {
+		list.insertAfter(nil, data)
+		return nil
+	}
+
+	current := list.Head
+
+	for current.Next != nil && current.Next.Data != beforeValue {
+		current = current.Next
+	}
+
+	if current.Next == nil {
+		return ErrNotFound
+	}
+
+	list.insertAfter(current, data)
+	return nil
+}
+
+/*
+InsertInSortedList inserts a new node in a sorted linked list
+It takes the data to be inserted as an argument
+It creates a new node with the given data and inserts it in the sorted list
+It iterates through the list to find the correct position to insert the new node
+If the list is empty or the data is smaller than the head, it inserts the new node at the beginning
+If the data is greater than the head, it iterates through the list to find the correct position to insert the new node
+If the list has no Compare function, it returns ErrNoComparator
+*/
+func (list *LinkedList[T]) InsertInSortedList(data T) error {
+	if list.Compare == nil {
+		return ErrNoComparator
+	}
+
+	if list.Head == nil || list.Compare(list.Head.Data, data) >= 0 {
+		list.insertAfter(nil, data)
+		return nil
+	}
+
+	current := list.Head
+
+	for current.Next != nil && list.Compare(current.Next.Data, data) <= 0 {
+		current = current.Next
+	}
+
+	list.insertAfter(current, data)
+	return nil
+}
+
+/*
+InsertAtSpecficPosition inserts a new node at a specific position in the linked list
+It takes the data to be inserted and the position at which the new node is to be inserted as arguments
+It creates a new node with the given data and inserts it at the given position
+It iterates through the list to find the node before the given position
+If the position is 0, it inserts the new node at the beginning
+If the position is negative or greater than the length of the list, it returns ErrIndexOutOfRange
+If the position is found, it inserts the new node at the given position
+*/
+func (list *LinkedList[T]) InsertAtSpecficPosition(data T, position int) error {
+	if position < 0 {
+		return ErrIndexOutOfRange
+	}
+
+	if position == 0 {
+		list.insertAfter(nil, data)
+		return nil
+	}
+
+	current := list.Head
+	currentPostion := 0
+	for current != nil && currentPostion < position-1 {
+		current = current.Next
+		currentPostion++
+	}
+
+	if current == nil {
+		return ErrIndexOutOfRange
+	}
+
+	list.insertAfter(current, data)
+	return nil
+}
+
+/*
+UpdateValueByOldValue updates the value of a node with a given old value in the linked list
+It takes the old value and the new value as arguments
+It iterates through the list to find the node with the given old value
+If the list is empty, it returns ErrEmpty
+If the node is found, it updates the value of the node with the new value
+If the node is not found, it returns ErrNotFound
+*/
+func (list *LinkedList[T]) UpdateValueByOldValue(oldValue, newValue T) error {
+	if list.Head == nil {
+		return ErrEmpty
+	}
+
+	current := list.Head
+
+	for current != nil && current.Data != oldValue {
+		current = current.Next
+	}
+
+	if current == nil {
+		return ErrNotFound
+	}
+
+	current.Data = newValue
+	return nil
+}
+
+/*
+UpdateAllValueByOldValue updates the value of all nodes with a given old value in the linked list
+It takes the old value and the new value as arguments
+It iterates through the list to find the nodes with the given old value
+If the node is found, it updates the value of the node with the new value
+It returns the number of updated nodes, ErrEmpty if the list is empty and ErrNotFound if nothing matched
+*/
+func (list *LinkedList[T]) UpdateAllValueByOldValue(oldValue, newValue T) (int, error) {
+	if list.Head == nil {
+		return 0, ErrEmpty
+	}
+
+	updated := 0
+	current := list.Head
+
+	for current != nil {
+		if current.Data == oldValue {
+			current.Data = newValue
+			updated++
+		}
+		current = current.Next
+	}
+
+	if updated == 0 {
+		return 0, ErrNotFound
+	}
+
+	return updated, nil
+}
+
+/*
+UpdateByPosition updates the value of a node at a given position in the linked list
+It takes the data and the position as arguments
+It iterates through the list to find the node at the given position
+If the list is empty, it returns ErrEmpty
+If the position is negative or not smaller than the length of the list, it returns ErrIndexOutOfRange
+If the position is found, it updates the value of the node at the given position
+*/
+func (list *LinkedList[T]) UpdateByPosition(data T, position int) error {
+	if list.Head == nil {
+		return ErrEmpty
+	}
+
+	if position < 0 {
+		return ErrIndexOutOfRange
+	}
+
+	current := list.Head
+	currentPostion := 0
+	for current != nil && currentPostion < position {
+		current = current.Next
+		currentPostion++
+	}
+
+	if current == nil {
+		return ErrIndexOutOfRange
+	}
+
+	current.Data = data
+	return nil
+}
+
+/*
+DeleteByValue deletes the first node with a given value in the linked list
+It takes the value to be deleted as an argument
+It iterates through the list to find the node with the given value
+If the list is empty, it returns ErrEmpty
+If the node is found, it deletes the node
+If the node is not found, it returns ErrNotFound
+*/
+func (list *LinkedList[T]) DeleteByValue(data T) error {
+	if list.Head == nil {
+		return ErrEmpty
+	}
+
+	if list.Head.Data == data {
+		list.removeAfter(nil)
+		return nil
+	}
+
+	current := list.Head
+
+	for current.Next != nil && current.Next.Data != data {
+		current = current.Next
+	}
+
+	if current.Next == nil {
+		return ErrNotFound
+	}
+
+	list.removeAfter(current)
+	return nil
+}
+
+/*
+DeleteByIndex deletes the node at a given index in the linked list
+It takes the index of the node to be deleted as an argument
+It iterates through the list to find the node before the given index
+If the list is empty, it returns ErrEmpty
+If the index is 0, it deletes the head node
+If the index is negative or not smaller than the length of the list, it returns ErrIndexOutOfRange
+If the index is found, it deletes the node at the given index
+*/
+func (list *LinkedList[T]) DeleteByIndex(index int) error {
+	if list.Head == nil {
+		return ErrEmpty
+	}
+
+	if index < 0 {
+		return ErrIndexOutOfRange
+	}
+
+	if index == 0 {
+		list.removeAfter(nil)
+		return nil
+	}
+
+	currentPostion := 0
+	current := list.Head
+	for current.Next != nil && currentPostion < index-1 {
+		current = current.Next
+		currentPostion++
+	}
+
+	if current.Next == nil {
+		return ErrIndexOutOfRange
+	}
+
+	list.removeAfter(current)
+	return nil
+}
+
+/*
+DeleteAllByValue deletes all nodes with a given value in the linked list
+It takes the value to be deleted as an argument
+It first drops every matching node from the head, then iterates through the rest of the list
+It returns the number of deleted nodes, ErrEmpty if the list is empty and ErrNotFound if nothing matched
+*/
+func (list *LinkedList[T]) DeleteAllByValue(value T) (int, error) {
+	if list.Head == nil {
+		return 0, ErrEmpty
+	}
+
+	deleted := 0
+	for list.Head != nil && list.Head.Data == value {
+		list.removeAfter(nil)
+		deleted++
+	}
+
+	current := list.Head
+
+	for current != nil && current.Next != nil {
+		if current.Next.Data == value {
+			list.removeAfter(current)
+			deleted++
+			continue
+		}
+		current = current.Next
+	}
+
+	if deleted == 0 {
+		return 0, ErrNotFound
+	}
+
+	return deleted, nil
+}
+
+/*
+Length counts the nodes by walking the list, use Len for the O(1) cached size
+If the nodes form a cycle it returns ErrCycle instead of looping forever
+*/
+func (list *LinkedList[T]) Length() (int, error) {
+	if list.HasCycle() {
+		return 0, ErrCycle
+	}
+
+	n := 0
+	current := list.Head
+	for current != nil {
+		current = current.Next
+		n++
+	}
+
+	return n, nil
+}
+
+// FindIndexByValue returns the position of the first node holding data, or ErrNotFound
+func (list *LinkedList[T]) FindIndexByValue(data T) (int, error) {
+	n := 0
+	current := list.Head
+	for current != nil && current.Data != data {
+		current = current.Next
+		n++
+	}
+
+	if current == nil {
+		return -1, ErrNotFound
+	}
+
+	return n, nil
+}
+
+func (list *LinkedList[T]) PrintReverseWithDefer() {
+	current := list.Head
+	for current != nil {
+		defer fmt.Println("Data is :", current.Data)
+		current = current.Next
+	}
+}
+
+func (list *LinkedList[T]) PrintReverseWithRecursion() {
+
+	var printReverse func(node *Node[T])
+	printReverse = func(node *Node[T]) {
+		if node == nil {
+			return
+		}
+		printReverse(node.Next)
+		fmt.Println("Data:", node.Data)
+	}
+
+	printReverse(list.Head)
+}
+
+// FindMax returns the largest value in the linked list, or ErrEmpty / ErrNoComparator
+func (list *LinkedList[T]) FindMax() (T, error) {
+	var zero T
+	if list.Compare == nil {
+		return zero, ErrNoComparator
+	}
+
+	if list.Head == nil {
+		return zero, ErrEmpty
+	}
+
+	max := list.Head.Data
+	current := list.Head
+	for current != nil {
+		if list.Compare(current.Data, max) > 0 {
+			max = current.Data
+		}
+		current = current.Next
+	}
+
+	return max, nil
+}
+
+// FindMin returns the smallest value in the linked list, or ErrEmpty / ErrNoComparator
+func (list *LinkedList[T]) FindMin() (T, error) {
+	var zero T
+	if list.Compare == nil {
+		return zero, ErrNoComparator
+	}
+
+	if list.Head == nil {
+		return zero, ErrEmpty
+	}
+
+	min := list.Head.Data
+	current := list.Head
+	for current != nil {
+		if list.Compare(current.Data, min) < 0 {
+			min = current.Data
+		}
+		current = current.Next
+	}
+
+	return min, nil
+}
+
+/*
+FindMiddle returns the value of the middle node, or ErrEmpty
+It moves a slow pointer one step and a fast pointer two steps at a time
+For an even number of nodes it returns the second of the two middle nodes
+If the two pointers ever meet the nodes form a cycle and it returns ErrCycle
+*/
+func (list *LinkedList[T]) FindMiddle() (T, error) {
+	if list.Head == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	slow := list.Head
+	fast := list.Head
+
+	for fast != nil && fast.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+		if slow == fast {
+			var zero T
+			return zero, ErrCycle
+		}
+	}
+
+	return slow.Data, nil
+}
+
+// Reverse reverses the links in place, the old Head becomes the Tail
+func (list *LinkedList[T]) Reverse() {
+	list.Tail = list.Head
+	var prev *Node[T]
+	current := list.Head
+	for current != nil {
+		next := current.Next
+		current.Next = prev
+		prev = current
+		current = next
+	}
+	list.Head = prev
+}
@@ -0,0 +1,95 @@
+package linkedlist_test
+
+import (
+	"fmt"
+	"testing"
+
+	"generic/linkedlist"
+	"generic/linkedlist/listtest"
+)
+
+// unordered returns a list without a Compare function, the ordered operations must return ErrNoComparator
+func unordered() *linkedlist.LinkedList[int] {
+	return &linkedlist.LinkedList[int]{}
+}
+
+// pooled returns a list that takes its nodes from a small pool, so chunks run out and freed nodes get reused
+func pooled() *linkedlist.LinkedList[int] {
+	return linkedlist.New[int]().WithNodePool(linkedlist.NewNodePool[int](2))
+}
+
+// slab returns a slab list that starts without room, so the slice grows while the programs run
+func slab() *linkedlist.SlabList[int] {
+	return linkedlist.NewSlab[int](0)
+}
+
+func TestList(t *testing.T) {
+	t.Run("LinkedList", func(t *testing.T) { listtest.Run(t, linkedlist.New[int], listtest.LinkedListErrors) })
+	t.Run("Unordered", func(t *testing.T) { listtest.Run(t, unordered, listtest.LinkedListErrors) })
+	t.Run("NodePool", func(t *testing.T) { listtest.Run(t, pooled, listtest.LinkedListErrors) })
+	t.Run("SlabList", func(t *testing.T) { listtest.Run(t, slab, listtest.LinkedListErrors) })
+}
+
+func FuzzList(f *testing.F) {
+	listtest.Fuzz(f, linkedlist.New[int], listtest.LinkedListErrors)
+}
+
+func FuzzNodePool(f *testing.F) {
+	listtest.Fuzz(f, pooled, listtest.LinkedListErrors)
+}
+
+func FuzzSlabList(f *testing.F) {
+	listtest.Fuzz(f, slab, listtest.LinkedListErrors)
+}
+
+// sizes are the list lengths the complexity benchmarks run at, an O(1) operation takes the same time at every size
+var sizes = []int{100, 1_000, 10_000}
+
+/*
+BenchmarkPushBack appends n values to an empty list
+PushBack links after the cached Tail, Traversal walks from the Head to the end first as InsertAtBack used to,
+so building the list is O(n) with one and O(n²) with the other
+*/
+func BenchmarkPushBack(b *testing.B) {
+	for _, n := range sizes {
+		b.Run(fmt.Sprintf("PushBack/n=%d", n), func(b *testing.B) {
+			for range b.N {
+				list := linkedlist.New[int]()
+				for i := range n {
+					list.PushBack(i)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("Traversal/n=%d", n), func(b *testing.B) {
+			for range b.N {
+				list := linkedlist.New[int]()
+				for i := range n {
+					list.InsertAtSpecficPosition(i, i)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkLen reads the length of a list of n values, Len returns the cached size and Length walks every node
+func BenchmarkLen(b *testing.B) {
+	for _, n := range sizes {
+		list := linkedlist.New[int]()
+		for i := range n {
+			list.PushBack(i)
+		}
+
+		b.Run(fmt.Sprintf("Len/n=%d", n), func(b *testing.B) {
+			for range b.N {
+				list.Len()
+			}
+		})
+
+		b.Run(fmt.Sprintf("Length/n=%d", n), func(b *testing.B) {
+			for range b.N {
+				list.Length()
+			}
+		})
+	}
+}
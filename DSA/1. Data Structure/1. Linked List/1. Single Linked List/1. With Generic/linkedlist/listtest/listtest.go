@@ -0,0 +1,608 @@
+/*
+Package listtest is a conformance suite for lists of ints
+It runs programs of list operations against the list and against a slice-backed reference model,
+and after every step checks that both agree: the returned values and errors, the values from front to back,
+the cached size and, for the lists that have them, the walked length, the backward values and the Tail pointer
+A program is a byte string where every three bytes are one step (the operation and two arguments),
+so the same programs can come from a table, from a random generator or from the fuzzer
+
+A list only has to implement List, every other operation is found by type assertion and a step whose operation
+the list does not have is skipped, so LinkedList runs every step and SlabList the handful it implements
+
+Use it from a test file of the package that builds the list:
+
+	func TestList(t *testing.T) { listtest.Run(t, linkedlist.New[int], listtest.LinkedListErrors) }
+	func FuzzList(f *testing.F) { listtest.Fuzz(f, linkedlist.New[int], listtest.LinkedListErrors) }
+*/
+package listtest
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"generic/linkedlist"
+)
+
+// List is the part every list under test has, the values from front to back and the cached size
+type List interface {
+	Len() int
+	Values() iter.Seq[int]
+}
+
+/*
+Factory returns a new empty list for one program
+If the list has FindMax, the model finds out from it whether the list has a Compare function: it checks the ordered
+operations against ascending order when it has one and expects ErrNoComparator when it has none
+*/
+type Factory[L List] func() L
+
+/*
+Errors are the sentinel errors of the package under test, the model expects them where the operations fail
+Results are compared with errors.Is, so the list may wrap them
+*/
+type Errors struct {
+	NotFound        error
+	IndexOutOfRange error
+	Empty           error
+	NoComparator    error
+}
+
+// LinkedListErrors are the sentinel errors of the linkedlist package
+var LinkedListErrors = Errors{
+	NotFound:        linkedlist.ErrNotFound,
+	IndexOutOfRange: linkedlist.ErrIndexOutOfRange,
+	Empty:           linkedlist.ErrEmpty,
+	NoComparator:    linkedlist.ErrNoComparator,
+}
+
+// Seeds are the programs Run always checks and Fuzz starts from, each one covers an edge case
+var Seeds = map[string][]byte{
+	// UpdateByPosition at 0 must update the Head only, the old version fell through and overwrote twice
+	"UpdateByPositionHead":  {pushBack, 1, 0, pushBack, 2, 0, pushBack, 3, 0, updateByPosition, 7, 1},
+	"UpdateByPositionTail":  {pushBack, 1, 0, pushBack, 2, 0, updateByPosition, 7, 2, pushBack, 3, 0},
+	"UpdateByPositionEmpty": {updateByPosition, 7, 1},
+	"DeleteTailThenPush":    {pushBack, 1, 0, pushBack, 2, 0, deleteByIndex, 0, 2, pushBack, 3, 0},
+	"DeleteAllFromHead":     {pushBack, 1, 0, pushBack, 1, 0, pushBack, 2, 0, pushBack, 1, 0, deleteAllByValue, 1, 0},
+	"InsertAtEnd":           {pushBack, 1, 0, insertAtPosition, 5, 2, pushBack, 6, 0},
+	"InsertPastEnd":         {pushBack, 1, 0, insertAtPosition, 5, 3},
+	"PopLast":               {pushBack, 1, 0, popFront, 0, 0, pushBack, 2, 0},
+	"ReverseThenPush":       {pushBack, 1, 0, pushBack, 2, 0, reverse, 0, 0, pushBack, 3, 0},
+	"RotateThenPush":        {pushBack, 1, 0, pushBack, 2, 0, pushBack, 3, 0, rotate, 1, 0, pushBack, 4, 0},
+	"SortDedup":             {pushBack, 3, 0, pushBack, 1, 0, pushBack, 3, 0, sort, 0, 0, dedup, 0, 0, pushBack, 0, 0},
+	"KGroupTail":            {pushBack, 1, 0, pushBack, 2, 0, pushBack, 3, 0, pushBack, 4, 0, reverseKGroup, 3, 0, pushBack, 5, 0},
+	"EmptyQueries":          {query, 0, 0, popFront, 0, 0, deleteByValue, 1, 0},
+	"InsertAfterTail":       {pushBack, 1, 0, insertAfterValue, 1, 2, pushBack, 3, 0, reverse, 0, 0, pushBack, 4, 0},
+	"DeleteOnlyValue":       {insertAtFront, 1, 0, deleteByValue, 1, 0, pushBack, 2, 0, query, 2, 1},
+}
+
+// The operations a program step can run, the operation byte picks one modulo operationCount
+const (
+	pushBack = iota
+	insertAtFront
+	insertAfterValue
+	insertBeforeValue
+	insertInSortedList
+	insertAtPosition
+	updateValueByOldValue
+	updateAllValueByOldValue
+	updateByPosition
+	deleteByValue
+	deleteByIndex
+	deleteAllByValue
+	popFront
+	reverse
+	sort
+	dedup
+	dedupAll
+	rotate
+	reverseKGroup
+	query
+	operationCount
+)
+
+/*
+Run checks the list returned by factory against the model
+It runs every program in Seeds and a fixed set of random programs, each one as its own subtest
+*/
+func Run[L List](t *testing.T, factory Factory[L], errs Errors) {
+	for name, program := range Seeds {
+		t.Run(name, func(t *testing.T) {
+			Check(t, factory(), errs, program)
+		})
+	}
+
+	random := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 200; i++ {
+		program := make([]byte, 3*random.IntN(64))
+		for j := range program {
+			program[j] = byte(random.UintN(256))
+		}
+
+		t.Run(fmt.Sprintf("Random%d", i), func(t *testing.T) {
+			Check(t, factory(), errs, program)
+		})
+	}
+}
+
+// Fuzz adds Seeds to the corpus of f and fuzzes programs against the list returned by factory
+func Fuzz[L List](f *testing.F, factory Factory[L], errs Errors) {
+	for _, program := range Seeds {
+		f.Add(program)
+	}
+
+	f.Fuzz(func(t *testing.T, program []byte) {
+		Check(t, factory(), errs, program)
+	})
+}
+
+/*
+Check runs program against list, which must be empty, and the model and fails t at the first step where they disagree
+errs are the errors of the package of list
+*/
+func Check(t testing.TB, list List, errs Errors, program []byte) {
+	t.Helper()
+
+	m := &model{ordered: hasComparator(list, errs), errors: errs}
+	for i := 0; i+2 < len(program); i += 3 {
+		name, got, want := step(list, m, program[i], program[i+1], program[i+2])
+		if !slices.Equal(got, want) {
+			t.Fatalf("step %d %s(%d, %d): got %v, want %v", i/3, name, program[i+1], program[i+2], got, want)
+		}
+
+		if err := m.verify(list); err != nil {
+			t.Fatalf("step %d %s(%d, %d): %v", i/3, name, program[i+1], program[i+2], err)
+		}
+	}
+}
+
+// hasComparator reports whether an empty list has a Compare function, a list without FindMax has no ordered operations
+func hasComparator(list List, errs Errors) bool {
+	finder, ok := list.(interface{ FindMax() (int, error) })
+	if !ok {
+		return false
+	}
+	_, err := finder.FindMax()
+	return !errors.Is(err, errs.NoComparator)
+}
+
+// model is the reference implementation, a plain slice, whether the list has a Compare function and its errors
+type model struct {
+	values  []int
+	ordered bool
+	errors  Errors
+}
+
+// value maps an argument byte to a small value, so programs hit equal values and duplicates often
+func value(b byte) int {
+	return int(b % 8)
+}
+
+// position maps an argument byte to a position from -1 to two past the last index, so both bounds get tested
+func (m *model) position(b byte) int {
+	return int(b)%(len(m.values)+3) - 1
+}
+
+// verify checks the structure of the list against the model
+func (m *model) verify(list List) error {
+	values := slices.Collect(list.Values())
+	if !slices.Equal(values, m.values) {
+		return fmt.Errorf("values %v, want %v", values, m.values)
+	}
+
+	if list.Len() != len(m.values) {
+		return fmt.Errorf("Len %d, want %d", list.Len(), len(m.values))
+	}
+
+	switch walker := list.(type) {
+	case interface{ Length() (int, error) }:
+		if length, err := walker.Length(); err != nil || length != len(m.values) {
+			return fmt.Errorf("Length %d, %v, want %d", length, err, len(m.values))
+		}
+	case interface{ Length() int }:
+		if length := walker.Length(); length != len(m.values) {
+			return fmt.Errorf("Length %d, want %d", length, len(m.values))
+		}
+	}
+
+	if backward, ok := list.(interface{ Backward() iter.Seq[int] }); ok {
+		values := slices.Collect(backward.Backward())
+		slices.Reverse(values)
+		if !slices.Equal(values, m.values) {
+			return fmt.Errorf("Backward %v, want the reverse of %v", values, m.values)
+		}
+	}
+
+	if linked, ok := list.(*linkedlist.LinkedList[int]); ok {
+		return m.verifyLinks(linked)
+	}
+	return nil
+}
+
+// verifyLinks checks the Head and Tail pointers of a LinkedList against the model
+func (m *model) verifyLinks(list *linkedlist.LinkedList[int]) error {
+	if len(m.values) == 0 {
+		if list.Head != nil || list.Tail != nil {
+			return errors.New("Head or Tail is set on an empty list")
+		}
+		return nil
+	}
+
+	if list.Tail == nil || list.Tail.Next != nil || list.Tail.Data != m.values[len(m.values)-1] {
+		return errors.New("Tail is not the last node")
+	}
+	return nil
+}
+
+// errorOf returns the sentinel err wraps, so results can be compared with ==
+func (m *model) errorOf(err error) error {
+	for _, sentinel := range []error{m.errors.NotFound, m.errors.IndexOutOfRange, m.errors.Empty, m.errors.NoComparator} {
+		if errors.Is(err, sentinel) {
+			return sentinel
+		}
+	}
+	return err
+}
+
+// search returns the error a value based operation must return for a value found at index i
+func (m *model) search(i int) error {
+	switch {
+	case len(m.values) == 0:
+		return m.errors.Empty
+	case i < 0:
+		return m.errors.NotFound
+	}
+	return nil
+}
+
+// unsupported is what step returns for an operation the list does not have, the step changes nothing
+func unsupported(name string) (string, []any, []any) {
+	return name + " (unsupported)", nil, nil
+}
+
+/*
+step runs one operation on the list and on the model
+It returns the name of the operation and what the list and the model returned, values and errors in order
+*/
+func step(list List, m *model, operation, a, b byte) (string, []any, []any) {
+	switch int(operation) % operationCount {
+	case pushBack:
+		switch l := list.(type) {
+		case interface{ PushBack(int) }:
+			l.PushBack(value(a))
+		case interface{ InsertAtBack(int) }:
+			l.InsertAtBack(value(a))
+		default:
+			return unsupported("PushBack")
+		}
+		m.values = append(m.values, value(a))
+		return "PushBack", nil, nil
+
+	case insertAtFront:
+		switch l := list.(type) {
+		case interface{ InsertAtFront(int) }:
+			l.InsertAtFront(value(a))
+		case interface{ PushFront(int) }:
+			l.PushFront(value(a))
+		default:
+			return unsupported("InsertAtFront")
+		}
+		m.values = slices.Insert(m.values, 0, value(a))
+		return "InsertAtFront", nil, nil
+
+	case insertAfterValue:
+		l, ok := list.(interface{ InsertAfterValue(int, int) error })
+		if !ok {
+			return unsupported("InsertAfterValue")
+		}
+		got := m.errorOf(l.InsertAfterValue(value(a), value(b)))
+		i := slices.Index(m.values, value(a))
+		want := m.search(i)
+		if want == nil {
+			m.values = slices.Insert(m.values, i+1, value(b))
+		}
+		return "InsertAfterValue", []any{got}, []any{want}
+
+	case insertBeforeValue:
+		l, ok := list.(interface{ InsertBeforeValue(int, int) error })
+		if !ok {
+			return unsupported("InsertBeforeValue")
+		}
+		got := m.errorOf(l.InsertBeforeValue(value(a), value(b)))
+		i := slices.Index(m.values, value(a))
+		want := m.search(i)
+		if want == nil {
+			m.values = slices.Insert(m.values, i, value(b))
+		}
+		return "InsertBeforeValue", []any{got}, []any{want}
+
+	case insertInSortedList:
+		l, ok := list.(interface{ InsertInSortedList(int) error })
+		if !ok {
+			return unsupported("InsertInSortedList")
+		}
+		// Where an unsorted list gets the value is up to the implementation, so only sorted lists are checked
+		if m.ordered && !slices.IsSorted(m.values) {
+			return "InsertInSortedList (unsorted)", nil, nil
+		}
+		got := m.errorOf(l.InsertInSortedList(value(a)))
+		if !m.ordered {
+			return "InsertInSortedList", []any{got}, []any{m.errors.NoComparator}
+		}
+
+		// After the values that are not larger, equal values keep their insertion order
+		i, _ := slices.BinarySearch(m.values, value(a)+1)
+		m.values = slices.Insert(m.values, i, value(a))
+		return "InsertInSortedList", []any{got}, []any{nil}
+
+	case insertAtPosition:
+		l, ok := list.(interface{ InsertAtSpecficPosition(int, int) error })
+		if !ok {
+			return unsupported("InsertAtSpecficPosition")
+		}
+		position := m.position(b)
+		got := m.errorOf(l.InsertAtSpecficPosition(value(a), position))
+		if position < 0 || position > len(m.values) {
+			return "InsertAtSpecficPosition", []any{got}, []any{m.errors.IndexOutOfRange}
+		}
+		m.values = slices.Insert(m.values, position, value(a))
+		return "InsertAtSpecficPosition", []any{got}, []any{nil}
+
+	case updateValueByOldValue:
+		l, ok := list.(interface{ UpdateValueByOldValue(int, int) error })
+		if !ok {
+			return unsupported("UpdateValueByOldValue")
+		}
+		got := m.errorOf(l.UpdateValueByOldValue(value(a), value(b)))
+		i := slices.Index(m.values, value(a))
+		want := m.search(i)
+		if want == nil {
+			m.values[i] = value(b)
+		}
+		return "UpdateValueByOldValue", []any{got}, []any{want}
+
+	case updateAllValueByOldValue:
+		l, ok := list.(interface{ UpdateAllValueByOldValue(int, int) (int, error) })
+		if !ok {
+			return unsupported("UpdateAllValueByOldValue")
+		}
+		count, err := l.UpdateAllValueByOldValue(value(a), value(b))
+		updated := 0
+		for i := range m.values {
+			if m.values[i] == value(a) {
+				m.values[i] = value(b)
+				updated++
+			}
+		}
+		want := error(nil)
+		switch {
+		case len(m.values) == 0:
+			want = m.errors.Empty
+		case updated == 0:
+			want = m.errors.NotFound
+		}
+		return "UpdateAllValueByOldValue", []any{count, m.errorOf(err)}, []any{updated, want}
+
+	case updateByPosition:
+		l, ok := list.(interface{ UpdateByPosition(int, int) error })
+		if !ok {
+			return unsupported("UpdateByPosition")
+		}
+		position := m.position(b)
+		got := m.errorOf(l.UpdateByPosition(value(a), position))
+		switch {
+		case len(m.values) == 0:
+			return "UpdateByPosition", []any{got}, []any{m.errors.Empty}
+		case position < 0 || position >= len(m.values):
+			return "UpdateByPosition", []any{got}, []any{m.errors.IndexOutOfRange}
+		}
+		m.values[position] = value(a)
+		return "UpdateByPosition", []any{got}, []any{nil}
+
+	case deleteByValue:
+		l, ok := list.(interface{ DeleteByValue(int) error })
+		if !ok {
+			return unsupported("DeleteByValue")
+		}
+		got := m.errorOf(l.DeleteByValue(value(a)))
+		i := slices.Index(m.values, value(a))
+		want := m.search(i)
+		if want == nil {
+			m.values = slices.Delete(m.values, i, i+1)
+		}
+		return "DeleteByValue", []any{got}, []any{want}
+
+	case deleteByIndex:
+		l, ok := list.(interface{ DeleteByIndex(int) error })
+		if !ok {
+			return unsupported("DeleteByIndex")
+		}
+		position := m.position(b)
+		got := m.errorOf(l.DeleteByIndex(position))
+		switch {
+		case len(m.values) == 0:
+			return "DeleteByIndex", []any{got}, []any{m.errors.Empty}
+		case position < 0 || position >= len(m.values):
+			return "DeleteByIndex", []any{got}, []any{m.errors.IndexOutOfRange}
+		}
+		m.values = slices.Delete(m.values, position, position+1)
+		return "DeleteByIndex", []any{got}, []any{nil}
+
+	case deleteAllByValue:
+		l, ok := list.(interface{ DeleteAllByValue(int) (int, error) })
+		if !ok {
+			return unsupported("DeleteAllByValue")
+		}
+		count, err := l.DeleteAllByValue(value(a))
+		before := len(m.values)
+		m.values = slices.DeleteFunc(m.values, func(v int) bool { return v == value(a) })
+		deleted := before - len(m.values)
+		want := error(nil)
+		switch {
+		case before == 0:
+			want = m.errors.Empty
+		case deleted == 0:
+			want = m.errors.NotFound
+		}
+		return "DeleteAllByValue", []any{count, m.errorOf(err)}, []any{deleted, want}
+
+	case popFront:
+		l, ok := list.(interface{ PopFront() (int, error) })
+		if !ok {
+			return unsupported("PopFront")
+		}
+		data, err := l.PopFront()
+		if len(m.values) == 0 {
+			return "PopFront", []any{data, m.errorOf(err)}, []any{0, m.errors.Empty}
+		}
+		front := m.values[0]
+		m.values = m.values[1:]
+		return "PopFront", []any{data, m.errorOf(err)}, []any{front, nil}
+
+	case reverse:
+		l, ok := list.(interface{ Reverse() })
+		if !ok {
+			return unsupported("Reverse")
+		}
+		l.Reverse()
+		slices.Reverse(m.values)
+		return "Reverse", nil, nil
+
+	case sort:
+		l, ok := list.(interface{ Sort() error })
+		if !ok {
+			return unsupported("Sort")
+		}
+		got := m.errorOf(l.Sort())
+		if !m.ordered {
+			return "Sort", []any{got}, []any{m.errors.NoComparator}
+		}
+		slices.Sort(m.values)
+		return "Sort", []any{got}, []any{nil}
+
+	case dedup:
+		l, ok := list.(interface{ Dedup() int })
+		if !ok {
+			return unsupported("Dedup")
+		}
+		removed := l.Dedup()
+		before := len(m.values)
+		m.values = slices.Compact(m.values)
+		return "Dedup", []any{removed}, []any{before - len(m.values)}
+
+	case dedupAll:
+		l, ok := list.(interface{ DedupAll() int })
+		if !ok {
+			return unsupported("DedupAll")
+		}
+		removed := l.DedupAll()
+		before := len(m.values)
+		seen := make(map[int]bool)
+		m.values = slices.DeleteFunc(m.values, func(v int) bool {
+			duplicate := seen[v]
+			seen[v] = true
+			return duplicate
+		})
+		return "DedupAll", []any{removed}, []any{before - len(m.values)}
+
+	case rotate:
+		l, ok := list.(interface{ Rotate(int) })
+		if !ok {
+			return unsupported("Rotate")
+		}
+		k := int(int8(a))
+		l.Rotate(k)
+		if n := len(m.values); n > 1 {
+			k = ((k % n) + n) % n
+			m.values = slices.Concat(m.values[n-k:], m.values[:n-k])
+		}
+		return "Rotate", nil, nil
+
+	case reverseKGroup:
+		l, ok := list.(interface{ ReverseKGroup(int) error })
+		if !ok {
+			return unsupported("ReverseKGroup")
+		}
+		k := int(a%5) - 1
+		got := m.errorOf(l.ReverseKGroup(k))
+		if k < 1 {
+			return "ReverseKGroup", []any{got}, []any{m.errors.IndexOutOfRange}
+		}
+		for start := 0; start+k <= len(m.values); start += k {
+			slices.Reverse(m.values[start : start+k])
+		}
+		return "ReverseKGroup", []any{got}, []any{nil}
+
+	default:
+		n := int(b) % (len(m.values) + 2)
+		got, want := m.query(list, value(a), n)
+		return "Query", got, want
+	}
+}
+
+/*
+query runs every read-only operation the list has and returns what the list and the model returned
+data is searched for and n is passed to NthFromEnd
+*/
+func (m *model) query(list List, data, n int) (got, want []any) {
+	// add records what the list returned and returns the function that records what the model expects
+	add := func(value int, err error) func(int, error) {
+		got = append(got, value, m.errorOf(err))
+		return func(value int, err error) {
+			want = append(want, value, err)
+		}
+	}
+
+	if l, ok := list.(interface{ FindIndexByValue(int) (int, error) }); ok {
+		if i := slices.Index(m.values, data); i >= 0 {
+			add(l.FindIndexByValue(data))(i, nil)
+		} else {
+			add(l.FindIndexByValue(data))(-1, m.errors.NotFound)
+		}
+	}
+	if l, ok := list.(interface{ FindMax() (int, error) }); ok {
+		add(l.FindMax())(m.extreme(slices.Max[[]int]))
+	}
+	if l, ok := list.(interface{ FindMin() (int, error) }); ok {
+		add(l.FindMin())(m.extreme(slices.Min[[]int]))
+	}
+	if l, ok := list.(interface{ FindMiddle() (int, error) }); ok {
+		add(l.FindMiddle())(m.at(len(m.values) / 2))
+	}
+	if l, ok := list.(interface{ Front() (int, error) }); ok {
+		add(l.Front())(m.at(0))
+	}
+	if l, ok := list.(interface{ Back() (int, error) }); ok {
+		add(l.Back())(m.at(len(m.values) - 1))
+	}
+	if l, ok := list.(interface{ NthFromEnd(int) (int, error) }); ok {
+		if n < 1 || n > len(m.values) {
+			add(l.NthFromEnd(n))(0, m.errors.IndexOutOfRange)
+		} else {
+			add(l.NthFromEnd(n))(m.values[len(m.values)-n], nil)
+		}
+	}
+	return got, want
+}
+
+// extreme returns what FindMax or FindMin must return, pick finds the value in a non-empty slice
+func (m *model) extreme(pick func([]int) int) (int, error) {
+	switch {
+	case !m.ordered:
+		return 0, m.errors.NoComparator
+	case len(m.values) == 0:
+		return 0, m.errors.Empty
+	}
+	return pick(m.values), nil
+}
+
+// at returns what a read of the value at index must return, ErrEmpty on an empty list
+func (m *model) at(index int) (int, error) {
+	if len(m.values) == 0 {
+		return 0, m.errors.Empty
+	}
+	return m.values[index], nil
+}
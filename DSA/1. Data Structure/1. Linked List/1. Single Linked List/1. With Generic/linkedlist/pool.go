@@ -0,0 +1,88 @@
+package linkedlist
+
+// DefaultChunkSize is the number of nodes a NodePool allocates at once when NewNodePool is given 0
+const DefaultChunkSize = 64
+
+/*
+NodePool recycles the nodes of one or more linked lists
+Deleted nodes are kept in a free list (linked through their Next field) and handed out again by the next insert,
+new nodes are cut from chunks of nodes allocated together, so a busy queue allocates almost nothing after warming up
+A pool is not safe for concurrent use: share it only between lists used by the same goroutine
+A nil *NodePool is valid and simply allocates every node on its own
+*/
+type NodePool[T comparable] struct {
+	free      *Node[T]
+	freeLen   int
+	chunk     []Node[T]
+	chunkSize int
+}
+
+// NewNodePool returns an empty pool that allocates chunkSize nodes at a time, 0 means DefaultChunkSize
+func NewNodePool[T comparable](chunkSize int) *NodePool[T] {
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	return &NodePool[T]{chunkSize: chunkSize}
+}
+
+// Free returns the number of recycled nodes waiting in the free list
+func (pool *NodePool[T]) Free() int {
+	if pool == nil {
+		return 0
+	}
+	return pool.freeLen
+}
+
+/*
+get returns a node holding data, taken from the free list, then from the current chunk,
+then from a freshly allocated chunk
+*/
+func (pool *NodePool[T]) get(data T) *Node[T] {
+	if pool == nil {
+		return &Node[T]{Data: data}
+	}
+
+	node := pool.free
+	if node != nil {
+		pool.free = node.Next
+		pool.freeLen--
+	} else {
+		if len(pool.chunk) == 0 {
+			pool.chunk = make([]Node[T], pool.chunkSize)
+		}
+		node = &pool.chunk[0]
+		pool.chunk = pool.chunk[1:]
+	}
+
+	node.Data = data
+	node.Next = nil
+	return node
+}
+
+/*
+put adds node to the free list and clears its data, so it no longer keeps the data alive
+Without a pool the node is only unlinked, it keeps its data for callers that still hold it
+*/
+func (pool *NodePool[T]) put(node *Node[T]) {
+	if pool == nil {
+		node.Next = nil
+		return
+	}
+
+	var zero T
+	node.Data = zero
+	node.Next = pool.free
+	pool.free = node
+	pool.freeLen++
+}
+
+/*
+WithNodePool makes the list take its nodes from pool and give deleted nodes back to it, and returns the list
+A nil pool goes back to allocating every node on its own
+Once a node is deleted it is reused by a later insert, so pointers to deleted nodes (from Head, CycleStart
+or Intersection) must not be kept around
+*/
+func (list *LinkedList[T]) WithNodePool(pool *NodePool[T]) *LinkedList[T] {
+	list.pool = pool
+	return list
+}
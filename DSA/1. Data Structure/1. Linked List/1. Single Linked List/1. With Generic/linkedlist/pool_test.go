@@ -0,0 +1,67 @@
+package linkedlist_test
+
+import (
+	"testing"
+
+	"generic/linkedlist"
+)
+
+// TestRemovedNodes checks that only a pool clears the nodes it takes back, a list without one leaves them intact
+func TestRemovedNodes(t *testing.T) {
+	list := linkedlist.New[string]()
+	list.PushBack("a")
+	list.PushBack("b")
+	head := list.Head
+	if v, err := list.PopFront(); err != nil || v != "a" {
+		t.Fatalf("PopFront: got %q, %v", v, err)
+	}
+	if head.Data != "a" || head.Next != nil {
+		t.Fatalf("without a pool the removed node holds %q and links to %v, want \"a\" and nil", head.Data, head.Next)
+	}
+
+	pool := linkedlist.NewNodePool[string](0)
+	list = linkedlist.New[string]().WithNodePool(pool)
+	list.PushBack("a")
+	list.PushBack("b")
+	head = list.Head
+	list.PopFront()
+	if head.Data != "" || pool.Free() != 1 {
+		t.Fatalf("with a pool the removed node holds %q and the pool has %d free nodes, want \"\" and 1", head.Data, pool.Free())
+	}
+	list.PushBack("c")
+	if list.Tail != head || pool.Free() != 0 {
+		t.Fatal("the pool did not reuse the removed node")
+	}
+}
+
+// churn keeps a queue of 64 values busy: every operation pushes one value at the back and pops one from the front
+func churn(b *testing.B, push func(int), pop func() (int, error)) {
+	b.ReportAllocs()
+	for i := 0; i < 64; i++ {
+		push(i)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		push(i)
+		pop()
+	}
+}
+
+// BenchmarkLinkedList is the baseline, every push allocates a node and every pop leaves one to the GC
+func BenchmarkLinkedList(b *testing.B) {
+	list := linkedlist.New[int]()
+	churn(b, list.PushBack, list.PopFront)
+}
+
+// BenchmarkNodePool recycles the popped nodes, after warming up a push allocates nothing
+func BenchmarkNodePool(b *testing.B) {
+	list := linkedlist.New[int]().WithNodePool(linkedlist.NewNodePool[int](0))
+	churn(b, list.PushBack, list.PopFront)
+}
+
+// BenchmarkSlabList keeps the nodes in one slice and reuses freed slots
+func BenchmarkSlabList(b *testing.B) {
+	list := linkedlist.NewSlab[int](128)
+	churn(b, list.PushBack, list.PopFront)
+}
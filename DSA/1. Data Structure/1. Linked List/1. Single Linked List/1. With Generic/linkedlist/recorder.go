@@ -0,0 +1,76 @@
+package linkedlist
+
+import (
+	"fmt"
+	"io"
+)
+
+// Renderer is anything that can draw itself as text and as Graphviz DOT, every list in this repository is one
+type Renderer interface {
+	RenderASCII() string
+	ToDOT() string
+}
+
+// Frame is a snapshot of a structure taken after one recorded operation
+type Frame struct {
+	Step  int
+	Label string
+	Err   error
+	ASCII string
+	DOT   string
+}
+
+/*
+Recorder runs operations on a structure and takes a Frame after each one
+The frames can be printed with Replay or turned into an animation by rendering the DOT of every frame,
+which makes it easy to see the exact step where a pointer goes wrong
+*/
+type Recorder struct {
+	target Renderer
+	frames []Frame
+}
+
+// NewRecorder returns a recorder for target, the first frame is the state before any operation
+func NewRecorder(target Renderer) *Recorder {
+	recorder := &Recorder{target: target}
+	recorder.snapshot("start", nil)
+	return recorder
+}
+
+// Record runs op, takes a frame labelled with label and returns the error of op
+func (recorder *Recorder) Record(label string, op func() error) error {
+	err := op()
+	recorder.snapshot(label, err)
+	return err
+}
+
+// snapshot appends a frame of the current state
+func (recorder *Recorder) snapshot(label string, err error) {
+	recorder.frames = append(recorder.frames, Frame{
+		Step:  len(recorder.frames),
+		Label: label,
+		Err:   err,
+		ASCII: recorder.target.RenderASCII(),
+		DOT:   recorder.target.ToDOT(),
+	})
+}
+
+// Frames returns the recorded frames in order
+func (recorder *Recorder) Frames() []Frame {
+	return recorder.frames
+}
+
+// Replay writes every frame as text: the step, the label, the error if there was one and the drawing
+func (recorder *Recorder) Replay(w io.Writer) error {
+	for _, frame := range recorder.frames {
+		header := fmt.Sprintf("Step %d : %s", frame.Step, frame.Label)
+		if frame.Err != nil {
+			header += " -> " + frame.Err.Error()
+		}
+
+		if _, err := fmt.Fprintf(w, "%s\n%s\n", header, frame.ASCII); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,239 @@
+package linkedlist
+
+import (
+	"fmt"
+	"iter"
+)
+
+// slabNode is a node of a SlabList, next is the slot of the following node and 0 means there is none
+type slabNode[T comparable] struct {
+	data T
+	next int
+}
+
+/*
+SlabList is a singly linked list stored in one slice, the links are slot numbers instead of pointers
+It is the array implementation of a linked list: inserting takes a free slot, deleting puts the slot on a free list
+that is linked through the same next field, so slots are reused and the slice only grows when every slot is in use
+The whole list is one allocation the garbage collector does not need to scan node by node,
+and walking it touches memory that is close together
+Slot 0 is never used so that 0 can mean "no node", which makes the zero value an empty list ready to use
+*/
+type SlabList[T comparable] struct {
+	nodes []slabNode[T]
+	head  int
+	tail  int
+	free  int
+	size  int
+}
+
+// NewSlab returns an empty slab list with room for capacity values before the slice has to grow
+func NewSlab[T comparable](capacity int) *SlabList[T] {
+	return &SlabList[T]{nodes: make([]slabNode[T], 1, capacity+1)}
+}
+
+/*
+alloc stores data in a free slot and returns the slot number
+It reuses the first slot of the free list, or appends a new slot when the free list is empty
+*/
+func (list *SlabList[T]) alloc(data T) int {
+	if list.free != 0 {
+		slot := list.free
+		list.free = list.nodes[slot].next
+		list.nodes[slot] = slabNode[T]{data: data}
+		return slot
+	}
+
+	if len(list.nodes) == 0 {
+		list.nodes = append(list.nodes, slabNode[T]{})
+	}
+	list.nodes = append(list.nodes, slabNode[T]{data: data})
+	return len(list.nodes) - 1
+}
+
+// release clears slot and puts it on the free list
+func (list *SlabList[T]) release(slot int) {
+	list.nodes[slot] = slabNode[T]{next: list.free}
+	list.free = slot
+}
+
+/*
+insertAfter links data right after the slot prev, 0 inserts at the front
+It is the single place where tail and size are updated on insertion
+*/
+func (list *SlabList[T]) insertAfter(prev int, data T) {
+	slot := list.alloc(data)
+	if prev == 0 {
+		list.nodes[slot].next = list.head
+		list.head = slot
+	} else {
+		list.nodes[slot].next = list.nodes[prev].next
+		list.nodes[prev].next = slot
+	}
+
+	if list.nodes[slot].next == 0 {
+		list.tail = slot
+	}
+	list.size++
+}
+
+/*
+removeAfter unlinks the node right after the slot prev and returns its data, 0 removes the front
+It is the single place where tail and size are updated on deletion
+*/
+func (list *SlabList[T]) removeAfter(prev int) T {
+	var slot int
+	if prev == 0 {
+		slot = list.head
+		list.head = list.nodes[slot].next
+	} else {
+		slot = list.nodes[prev].next
+		list.nodes[prev].next = list.nodes[slot].next
+	}
+
+	if list.tail == slot {
+		list.tail = prev
+	}
+	list.size--
+
+	data := list.nodes[slot].data
+	list.release(slot)
+	return data
+}
+
+// Len returns the number of values in the list
+func (list *SlabList[T]) Len() int {
+	return list.size
+}
+
+// Cap returns the number of slots, used and free, the list can hold before its slice has to grow
+func (list *SlabList[T]) Cap() int {
+	if cap(list.nodes) == 0 {
+		return 0
+	}
+	return cap(list.nodes) - 1
+}
+
+// PushBack adds data at the end of the list in O(1)
+func (list *SlabList[T]) PushBack(data T) {
+	list.insertAfter(list.tail, data)
+}
+
+// PushFront adds data at the front of the list in O(1)
+func (list *SlabList[T]) PushFront(data T) {
+	list.insertAfter(0, data)
+}
+
+// PopFront removes the first value in O(1) and returns it, or ErrEmpty
+func (list *SlabList[T]) PopFront() (T, error) {
+	if list.head == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.removeAfter(0), nil
+}
+
+// Front returns the first value, or ErrEmpty
+func (list *SlabList[T]) Front() (T, error) {
+	if list.head == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.nodes[list.head].data, nil
+}
+
+// Back returns the last value, or ErrEmpty
+func (list *SlabList[T]) Back() (T, error) {
+	if list.tail == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.nodes[list.tail].data, nil
+}
+
+// InsertAfterValue inserts data after the first occurrence of afterValue, or returns ErrEmpty / ErrNotFound
+func (list *SlabList[T]) InsertAfterValue(afterValue, data T) error {
+	if list.head == 0 {
+		return ErrEmpty
+	}
+
+	for slot := list.head; slot != 0; slot = list.nodes[slot].next {
+		if list.nodes[slot].data == afterValue {
+			list.insertAfter(slot, data)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+// DeleteByValue deletes the first occurrence of data, or returns ErrEmpty / ErrNotFound
+func (list *SlabList[T]) DeleteByValue(data T) error {
+	if list.head == 0 {
+		return ErrEmpty
+	}
+
+	prev := 0
+	for slot := list.head; slot != 0; prev, slot = slot, list.nodes[slot].next {
+		if list.nodes[slot].data == data {
+			list.removeAfter(prev)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+// FindIndexByValue returns the position of the first occurrence of data, or ErrNotFound
+func (list *SlabList[T]) FindIndexByValue(data T) (int, error) {
+	index := 0
+	for slot := list.head; slot != 0; slot = list.nodes[slot].next {
+		if list.nodes[slot].data == data {
+			return index, nil
+		}
+		index++
+	}
+	return -1, ErrNotFound
+}
+
+// Reverse reverses the links in place, no slot moves
+func (list *SlabList[T]) Reverse() {
+	list.tail = list.head
+	prev := 0
+	for slot := list.head; slot != 0; {
+		next := list.nodes[slot].next
+		list.nodes[slot].next = prev
+		prev = slot
+		slot = next
+	}
+	list.head = prev
+}
+
+// Print prints the values from the front to the back
+func (list *SlabList[T]) Print() {
+	for slot := list.head; slot != 0; slot = list.nodes[slot].next {
+		fmt.Println("Data is :", list.nodes[slot].data)
+	}
+}
+
+// All returns an iterator over the positions and values, from the front to the back
+func (list *SlabList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for slot := list.head; slot != 0; slot = list.nodes[slot].next {
+			if !yield(index, list.nodes[slot].data) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Values returns an iterator over the values, from the front to the back
+func (list *SlabList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for slot := list.head; slot != 0; slot = list.nodes[slot].next {
+			if !yield(list.nodes[slot].data) {
+				return
+			}
+		}
+	}
+}
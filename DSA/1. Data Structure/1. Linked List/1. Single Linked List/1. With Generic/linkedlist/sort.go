@@ -0,0 +1,115 @@
+package linkedlist
+
+/*
+Sort sorts the linked list in ascending order using the list's Compare function
+It returns ErrNoComparator if the list has no Compare function
+See SortFunc for the details of the algorithm
+*/
+func (list *LinkedList[T]) Sort() error {
+	if list.Compare == nil {
+		return ErrNoComparator
+	}
+
+	list.SortFunc(func(a, b T) bool {
+		return list.Compare(a, b) < 0
+	})
+	return nil
+}
+
+/*
+SortFunc sorts the linked list using less as the ordering
+It is an in-place bottom-up merge sort over the nodes, no data is copied into a slice
+On every pass it merges neighbouring runs of width 1, 2, 4 ... until a single run is left
+Equal nodes keep their original order (the merge takes from the left run on ties), so the sort is stable
+It runs in O(n log n) time and O(1) extra space
+*/
+func (list *LinkedList[T]) SortFunc(less func(a, b T) bool) {
+	if list.Head == nil || list.Head.Next == nil {
+		return
+	}
+
+	dummy := &Node[T]{Next: list.Head}
+	tail := dummy
+
+	for width := 1; width < list.size; width *= 2 {
+		tail = dummy
+		current := dummy.Next
+		for current != nil {
+			left := current
+			right := splitAfter(left, width)
+			current = splitAfter(right, width)
+			tail = mergeInto(tail, left, right, less)
+		}
+	}
+
+	list.Head = dummy.Next
+	list.Tail = tail
+}
+
+/*
+IsSorted reports whether the linked list is in ascending order according to its Compare function
+It returns ErrNoComparator if the list has no Compare function
+*/
+func (list *LinkedList[T]) IsSorted() (bool, error) {
+	if list.Compare == nil {
+		return false, ErrNoComparator
+	}
+
+	current := list.Head
+	for current != nil && current.Next != nil {
+		if list.Compare(current.Next.Data, current.Data) < 0 {
+			return false, nil
+		}
+		current = current.Next
+	}
+
+	return true, nil
+}
+
+/*
+splitAfter cuts the chain starting at head after n nodes
+It returns the first node of the remaining chain, or nil if the chain was shorter than n
+*/
+func splitAfter[T comparable](head *Node[T], n int) *Node[T] {
+	for i := 1; head != nil && i < n; i++ {
+		head = head.Next
+	}
+
+	if head == nil {
+		return nil
+	}
+
+	rest := head.Next
+	head.Next = nil
+	return rest
+}
+
+/*
+mergeInto merges the sorted chains left and right and links the result after tail
+On ties the node from left is taken first, which keeps the merge stable
+It returns the last node of the merged chain
+*/
+func mergeInto[T comparable](tail, left, right *Node[T], less func(a, b T) bool) *Node[T] {
+	for left != nil && right != nil {
+		if less(right.Data, left.Data) {
+			tail.Next = right
+			right = right.Next
+		} else {
+			tail.Next = left
+			left = left.Next
+		}
+		tail = tail.Next
+	}
+
+	if left != nil {
+		tail.Next = left
+	} else {
+		tail.Next = right
+	}
+
+	for tail.Next != nil {
+		tail = tail.Next
+	}
+
+	return tail
+}
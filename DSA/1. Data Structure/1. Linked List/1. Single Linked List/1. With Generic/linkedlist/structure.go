@@ -0,0 +1,216 @@
+package linkedlist
+
+/*
+MergeSorted merges two sorted linked lists into a new sorted list using a's Compare function (or b's if a has none)
+The nodes are relinked, not copied, so a and b are left empty afterwards
+On equal values the node from a comes first, so the merge is stable
+It returns ErrNoComparator if neither list has a Compare function
+and ErrSameList if a and b are the same list, whose nodes would otherwise be linked against themselves
+*/
+func MergeSorted[T comparable](a, b *LinkedList[T]) (*LinkedList[T], error) {
+	if a == b {
+		return nil, ErrSameList
+	}
+
+	compare := a.Compare
+	if compare == nil {
+		compare = b.Compare
+	}
+	if compare == nil {
+		return nil, ErrNoComparator
+	}
+
+	merged := &LinkedList[T]{Compare: compare, size: a.size + b.size, pool: a.pool}
+	dummy := &Node[T]{}
+	tail := mergeInto(dummy, a.Head, b.Head, func(x, y T) bool {
+		return compare(x, y) < 0
+	})
+
+	merged.Head = dummy.Next
+	if merged.Head != nil {
+		merged.Tail = tail
+	}
+
+	a.clear()
+	b.clear()
+	return merged, nil
+}
+
+/*
+SplitHalf splits the linked list into a front and a back half
+For an odd number of nodes the extra node goes to the front half
+The nodes are relinked, not copied, so the receiver is left empty afterwards
+*/
+func (list *LinkedList[T]) SplitHalf() (front, back *LinkedList[T]) {
+	front = &LinkedList[T]{Compare: list.Compare, pool: list.pool}
+	back = &LinkedList[T]{Compare: list.Compare, pool: list.pool}
+	if list.Head == nil {
+		return front, back
+	}
+
+	frontSize := (list.size + 1) / 2
+	last := list.Head
+	for i := 1; i < frontSize; i++ {
+		last = last.Next
+	}
+
+	front.Head, front.Tail, front.size = list.Head, last, frontSize
+	if last.Next != nil {
+		back.Head, back.Tail, back.size = last.Next, list.Tail, list.size-frontSize
+	}
+	last.Next = nil
+
+	list.clear()
+	return front, back
+}
+
+/*
+NthFromEnd returns the value of the nth node from the end, where n = 1 is the Tail
+It moves a lead pointer n nodes ahead and then moves both pointers until the lead reaches the end
+If n is smaller than 1 or larger than the length of the list, it returns ErrIndexOutOfRange
+*/
+func (list *LinkedList[T]) NthFromEnd(n int) (T, error) {
+	var zero T
+	if n < 1 {
+		return zero, ErrIndexOutOfRange
+	}
+
+	lead := list.Head
+	for i := 0; i < n; i++ {
+		if lead == nil {
+			return zero, ErrIndexOutOfRange
+		}
+		lead = lead.Next
+	}
+
+	current := list.Head
+	for lead != nil {
+		lead = lead.Next
+		current = current.Next
+	}
+
+	return current.Data, nil
+}
+
+/*
+ReverseKGroup reverses the nodes of the linked list k at a time
+If the number of remaining nodes at the end is less than k they are left as they are
+If k is smaller than 1, it returns ErrIndexOutOfRange
+*/
+func (list *LinkedList[T]) ReverseKGroup(k int) error {
+	if k < 1 {
+		return ErrIndexOutOfRange
+	}
+
+	dummy := &Node[T]{Next: list.Head}
+	groupPrev := dummy
+	for {
+		kth := groupPrev
+		for i := 0; i < k && kth != nil; i++ {
+			kth = kth.Next
+		}
+		if kth == nil {
+			break
+		}
+
+		groupNext := kth.Next
+		first := groupPrev.Next
+		prev, current := groupNext, first
+		for current != groupNext {
+			next := current.Next
+			current.Next = prev
+			prev = current
+			current = next
+		}
+
+		groupPrev.Next = kth
+		groupPrev = first
+	}
+
+	list.Head = dummy.Next
+	if groupPrev != dummy && groupPrev.Next == nil {
+		list.Tail = groupPrev
+	}
+	return nil
+}
+
+/*
+Rotate rotates the linked list by k positions
+A positive k rotates to the right (the last k nodes move to the front)
+A negative k rotates to the left (the first k nodes move to the back)
+It joins the Tail to the Head and cuts the ring at the new Tail, so it runs in O(n)
+*/
+func (list *LinkedList[T]) Rotate(k int) {
+	if list.size < 2 {
+		return
+	}
+
+	k %= list.size
+	if k < 0 {
+		k += list.size
+	}
+	if k == 0 {
+		return
+	}
+
+	newTail := list.Head
+	for i := 1; i < list.size-k; i++ {
+		newTail = newTail.Next
+	}
+
+	list.Tail.Next = list.Head
+	list.Head = newTail.Next
+	newTail.Next = nil
+	list.Tail = newTail
+}
+
+/*
+Intersection returns the first node shared by the linked list and other, or nil if they do not meet
+Two pointers walk both lists and switch to the other list's Head when they reach the end
+After at most len(a) + len(b) steps they either meet at the shared node or both become nil
+*/
+func (list *LinkedList[T]) Intersection(other *LinkedList[T]) *Node[T] {
+	a, b := list.Head, other.Head
+	for a != b {
+		if a == nil {
+			a = other.Head
+		} else {
+			a = a.Next
+		}
+
+		if b == nil {
+			b = list.Head
+		} else {
+			b = b.Next
+		}
+	}
+
+	return a
+}
+
+/*
+Append splices all nodes of other onto the end of the linked list in O(1)
+The nodes are moved, not copied, so other is left empty afterwards
+*/
+func (list *LinkedList[T]) Append(other *LinkedList[T]) {
+	if other == list || other.Head == nil {
+		return
+	}
+
+	if list.Tail == nil {
+		list.Head = other.Head
+	} else {
+		list.Tail.Next = other.Head
+	}
+	list.Tail = other.Tail
+	list.size += other.size
+
+	other.clear()
+}
+
+// clear detaches every node from the list without touching the nodes themselves
+func (list *LinkedList[T]) clear() {
+	list.Head = nil
+	list.Tail = nil
+	list.size = 0
+}
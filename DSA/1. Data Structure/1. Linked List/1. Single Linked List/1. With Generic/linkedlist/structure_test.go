@@ -0,0 +1,106 @@
+package linkedlist_test
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"testing"
+
+	"generic/linkedlist"
+)
+
+// pair is a value ordered by key only, tag tells equal keys apart so stability can be checked
+type pair struct {
+	key int
+	tag byte
+}
+
+func pairs(tag byte, keys ...int) *linkedlist.LinkedList[pair] {
+	list := linkedlist.NewFunc(func(a, b pair) int { return cmp.Compare(a.key, b.key) })
+	for _, k := range keys {
+		list.PushBack(pair{k, tag})
+	}
+	return list
+}
+
+func TestMergeSorted(t *testing.T) {
+	a, b := pairs('a', 1, 3, 3, 5), pairs('b', 0, 3, 6)
+	merged, err := linkedlist.MergeSorted(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []pair{{0, 'b'}, {1, 'a'}, {3, 'a'}, {3, 'a'}, {3, 'b'}, {5, 'a'}, {6, 'b'}}
+	if got := slices.Collect(merged.Values()); !slices.Equal(got, want) || merged.Len() != len(want) {
+		t.Fatalf("MergeSorted: got %v with Len %d, want %v", got, merged.Len(), want)
+	}
+	if merged.Tail.Data != want[len(want)-1] {
+		t.Fatalf("MergeSorted: Tail %v, want %v", merged.Tail.Data, want[len(want)-1])
+	}
+	if a.Head != nil || a.Len() != 0 || b.Head != nil || b.Len() != 0 {
+		t.Fatal("MergeSorted left nodes in its inputs")
+	}
+
+	empty, err := linkedlist.MergeSorted(pairs('a'), pairs('b'))
+	if err != nil || empty.Head != nil || empty.Tail != nil || empty.Len() != 0 {
+		t.Fatalf("MergeSorted of empty lists: %v, %v", empty, err)
+	}
+
+	unordered := &linkedlist.LinkedList[int]{}
+	if _, err := linkedlist.MergeSorted(unordered, &linkedlist.LinkedList[int]{}); !errors.Is(err, linkedlist.ErrNoComparator) {
+		t.Fatalf("MergeSorted without Compare: got %v, want %v", err, linkedlist.ErrNoComparator)
+	}
+}
+
+// TestMergeSortedSameList checks that merging a list with itself fails and leaves the list as it was
+func TestMergeSortedSameList(t *testing.T) {
+	list := linkedlist.Collect(slices.Values([]int{1, 2, 3}))
+	if _, err := linkedlist.MergeSorted(list, list); !errors.Is(err, linkedlist.ErrSameList) {
+		t.Fatalf("MergeSorted(a, a): got %v, want %v", err, linkedlist.ErrSameList)
+	}
+	if got := slices.Collect(list.Values()); !slices.Equal(got, []int{1, 2, 3}) || list.Len() != 3 {
+		t.Fatalf("MergeSorted(a, a) changed the list to %v", got)
+	}
+}
+
+func TestSplitHalf(t *testing.T) {
+	for n := range 6 {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = i
+		}
+		list := linkedlist.Collect(slices.Values(values))
+		front, back := list.SplitHalf()
+
+		split := (n + 1) / 2
+		if got := slices.Collect(front.Values()); !slices.Equal(got, values[:split]) || front.Len() != split {
+			t.Fatalf("n = %d: front %v with Len %d, want %v", n, got, front.Len(), values[:split])
+		}
+		if got := slices.Collect(back.Values()); !slices.Equal(got, values[split:]) || back.Len() != n-split {
+			t.Fatalf("n = %d: back %v with Len %d, want %v", n, got, back.Len(), values[split:])
+		}
+		if list.Head != nil || list.Len() != 0 {
+			t.Fatalf("n = %d: SplitHalf left nodes in the list", n)
+		}
+
+		front.Append(back)
+		front.Append(front)
+		if got := slices.Collect(front.Values()); !slices.Equal(got, values) || front.Len() != n {
+			t.Fatalf("n = %d: Append of the halves got %v, want %v", n, got, values)
+		}
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	shared := linkedlist.Collect(slices.Values([]int{7, 8}))
+	a := linkedlist.Collect(slices.Values([]int{1, 2, 3}))
+	b := linkedlist.Collect(slices.Values([]int{4}))
+	a.Tail.Next, b.Tail.Next = shared.Head, shared.Head
+
+	if node := a.Intersection(b); node != shared.Head {
+		t.Fatalf("Intersection: got %v, want the node holding 7", node)
+	}
+	if node := a.Intersection(linkedlist.Collect(slices.Values([]int{7, 8}))); node != nil {
+		t.Fatalf("Intersection of lists with equal values but no shared node: got %v", node.Data)
+	}
+}
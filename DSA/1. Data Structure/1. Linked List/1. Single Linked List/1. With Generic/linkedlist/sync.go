@@ -0,0 +1,411 @@
+package linkedlist
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"sync"
+)
+
+/*
+SyncList is a LinkedList that is safe to share between goroutines
+Every operation runs behind a sync.RWMutex: the read-only operations share a read lock,
+everything that changes the list takes the write lock
+The inner list and its nodes are never handed out, so callers cannot bypass the lock,
+use With to run a sequence of operations (or one that needs the nodes) as a single atomic step
+*/
+type SyncList[T comparable] struct {
+	mu   sync.RWMutex
+	list LinkedList[T]
+}
+
+// NewSync returns an empty synchronized list of an ordered type that uses cmp.Compare for the ordered operations
+func NewSync[T cmp.Ordered]() *SyncList[T] {
+	return &SyncList[T]{list: LinkedList[T]{Compare: cmp.Compare[T]}}
+}
+
+// NewSyncFunc returns an empty synchronized list that uses the given compare function for the ordered operations
+func NewSyncFunc[T comparable](compare func(a, b T) int) *SyncList[T] {
+	return &SyncList[T]{list: LinkedList[T]{Compare: compare}}
+}
+
+/*
+With calls fn with the inner list while holding the write lock
+Everything fn does is atomic for the other goroutines, but fn must not keep the list or its nodes after it returns
+and must not call back into the SyncList, that would deadlock
+*/
+func (list *SyncList[T]) With(fn func(list *LinkedList[T])) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	fn(&list.list)
+}
+
+// Print prints the list, see LinkedList.Print
+func (list *SyncList[T]) Print() error {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	return list.list.Print()
+}
+
+// PrintReverseWithDefer prints the list from Tail to Head, see LinkedList.PrintReverseWithDefer
+func (list *SyncList[T]) PrintReverseWithDefer() {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	list.list.PrintReverseWithDefer()
+}
+
+// PrintReverseWithRecursion prints the list from Tail to Head, see LinkedList.PrintReverseWithRecursion
+func (list *SyncList[T]) PrintReverseWithRecursion() {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	list.list.PrintReverseWithRecursion()
+}
+
+// InsertAtBack adds data at the end of the list
+func (list *SyncList[T]) InsertAtBack(data T) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	list.list.InsertAtBack(data)
+}
+
+// PushBack adds data at the end of the list
+func (list *SyncList[T]) PushBack(data T) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	list.list.PushBack(data)
+}
+
+// InsertAtFront adds data at the start of the list
+func (list *SyncList[T]) InsertAtFront(data T) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	list.list.InsertAtFront(data)
+}
+
+// PopFront removes the first value and returns it, see LinkedList.PopFront
+func (list *SyncList[T]) PopFront() (T, error) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.PopFront()
+}
+
+// Back returns the last value, see LinkedList.Back
+func (list *SyncList[T]) Back() (T, error) {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	return list.list.Back()
+}
+
+// Len returns the number of values in the list
+func (list *SyncList[T]) Len() int {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	return list.list.Len()
+}
+
+// Length counts the values by walking the list, see LinkedList.Length
+func (list *SyncList[T]) Length() (int, error) {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	return list.list.Length()
+}
+
+// InsertAfterValue inserts data after the first occurrence of afterValue, see LinkedList.InsertAfterValue
+func (list *SyncList[T]) InsertAfterValue(afterValue, data T) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.InsertAfterValue(afterValue, data)
+}
+
+// InsertBeforeValue inserts data before the first occurrence of beforeValue, see LinkedList.InsertBeforeValue
+func (list *SyncList[T]) InsertBeforeValue(beforeValue, data T) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.InsertBeforeValue(beforeValue, data)
+}
+
+// InsertInSortedList inserts data keeping the list sorted, see LinkedList.InsertInSortedList
+func (list *SyncList[T]) InsertInSortedList(data T) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.InsertInSortedList(data)
+}
+
+// InsertAtSpecficPosition inserts data at position, see LinkedList.InsertAtSpecficPosition
+func (list *SyncList[T]) InsertAtSpecficPosition(data T, position int) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.InsertAtSpecficPosition(data, position)
+}
+
+// UpdateValueByOldValue replaces the first occurrence of oldValue, see LinkedList.UpdateValueByOldValue
+func (list *SyncList[T]) UpdateValueByOldValue(oldValue, newValue T) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.UpdateValueByOldValue(oldValue, newValue)
+}
+
+// UpdateAllValueByOldValue replaces every occurrence of oldValue, see LinkedList.UpdateAllValueByOldValue
+func (list *SyncList[T]) UpdateAllValueByOldValue(oldValue, newValue T) (int, error) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.UpdateAllValueByOldValue(oldValue, newValue)
+}
+
+// UpdateByPosition replaces the value at position, see LinkedList.UpdateByPosition
+func (list *SyncList[T]) UpdateByPosition(data T, position int) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.UpdateByPosition(data, position)
+}
+
+// DeleteByValue removes the first occurrence of data, see LinkedList.DeleteByValue
+func (list *SyncList[T]) DeleteByValue(data T) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.DeleteByValue(data)
+}
+
+// DeleteByIndex removes the value at index, see LinkedList.DeleteByIndex
+func (list *SyncList[T]) DeleteByIndex(index int) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.DeleteByIndex(index)
+}
+
+// DeleteAllByValue removes every occurrence of value, see LinkedList.DeleteAllByValue
+func (list *SyncList[T]) DeleteAllByValue(value T) (int, error) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.DeleteAllByValue(value)
+}
+
+// FindIndexByValue returns the index of the first occurrence of data, see LinkedList.FindIndexByValue
+func (list *SyncList[T]) FindIndexByValue(data T) (int, error) {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	return list.list.FindIndexByValue(data)
+}
+
+// FindMax returns the largest value, see LinkedList.FindMax
+func (list *SyncList[T]) FindMax() (T, error) {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	return list.list.FindMax()
+}
+
+// FindMin returns the smallest value, see LinkedList.FindMin
+func (list *SyncList[T]) FindMin() (T, error) {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	return list.list.FindMin()
+}
+
+// FindMiddle returns the middle value, see LinkedList.FindMiddle
+func (list *SyncList[T]) FindMiddle() (T, error) {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	return list.list.FindMiddle()
+}
+
+// Reverse reverses the list in place
+func (list *SyncList[T]) Reverse() {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	list.list.Reverse()
+}
+
+// Sort sorts the list with its Compare function, see LinkedList.Sort
+func (list *SyncList[T]) Sort() error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.Sort()
+}
+
+// SortFunc sorts the list with less, see LinkedList.SortFunc
+func (list *SyncList[T]) SortFunc(less func(a, b T) bool) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	list.list.SortFunc(less)
+}
+
+// IsSorted reports whether the list is sorted, see LinkedList.IsSorted
+func (list *SyncList[T]) IsSorted() (bool, error) {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	return list.list.IsSorted()
+}
+
+// Dedup removes adjacent duplicates, see LinkedList.Dedup
+func (list *SyncList[T]) Dedup() int {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.Dedup()
+}
+
+// DedupAll removes every duplicate, see LinkedList.DedupAll
+func (list *SyncList[T]) DedupAll() int {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.DedupAll()
+}
+
+// NthFromEnd returns the nth value from the end, see LinkedList.NthFromEnd
+func (list *SyncList[T]) NthFromEnd(n int) (T, error) {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	return list.list.NthFromEnd(n)
+}
+
+// ReverseKGroup reverses the list in groups of k, see LinkedList.ReverseKGroup
+func (list *SyncList[T]) ReverseKGroup(k int) error {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.ReverseKGroup(k)
+}
+
+// Rotate rotates the list by k positions, see LinkedList.Rotate
+func (list *SyncList[T]) Rotate(k int) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	list.list.Rotate(k)
+}
+
+// HasCycle reports whether the list has a cycle, one can only be made through With
+func (list *SyncList[T]) HasCycle() bool {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	return list.list.HasCycle()
+}
+
+// CycleLength returns the number of nodes in the cycle, see LinkedList.CycleLength
+func (list *SyncList[T]) CycleLength() int {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	return list.list.CycleLength()
+}
+
+// BreakCycle removes the cycle, see LinkedList.BreakCycle
+func (list *SyncList[T]) BreakCycle() bool {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	return list.list.BreakCycle()
+}
+
+/*
+Append moves the values of other to the end of the list and leaves other empty
+The two locks are never held together, so a.Append(b) and b.Append(a) running at the same time cannot deadlock,
+the price is that another goroutine can see the values in neither list for a moment
+*/
+func (list *SyncList[T]) Append(other *SyncList[T]) {
+	if other == list {
+		return
+	}
+
+	other.mu.Lock()
+	taken := other.list
+	other.list.clear()
+	other.mu.Unlock()
+
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	list.list.Append(&taken)
+}
+
+/*
+SplitHalf splits the list into a front and a back half, see LinkedList.SplitHalf
+Both halves are new synchronized lists and the receiver is left empty
+*/
+func (list *SyncList[T]) SplitHalf() (front, back *SyncList[T]) {
+	list.mu.Lock()
+	defer list.mu.Unlock()
+
+	frontList, backList := list.list.SplitHalf()
+	return &SyncList[T]{list: *frontList}, &SyncList[T]{list: *backList}
+}
+
+// Snapshot returns a copy of the values, from Head to Tail
+func (list *SyncList[T]) Snapshot() []T {
+	list.mu.RLock()
+	defer list.mu.RUnlock()
+
+	values := make([]T, 0, list.list.size)
+	for data := range list.list.Values() {
+		values = append(values, data)
+	}
+	return values
+}
+
+/*
+All returns an iterator over the positions and values of the list, from Head to Tail
+It ranges over a Snapshot taken when the iteration starts, so the loop body may call back into the list
+*/
+func (list *SyncList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, data := range list.Snapshot() {
+			if !yield(i, data) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over a Snapshot of the values, from Head to Tail
+func (list *SyncList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, data := range list.Snapshot() {
+			if !yield(data) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over a Snapshot of the values, from Tail to Head
+func (list *SyncList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, data := range slices.Backward(list.Snapshot()) {
+			if !yield(data) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package linkedlist_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+
+	"generic/linkedlist"
+)
+
+const (
+	workers   = 8
+	perWorker = 300
+)
+
+/*
+value returns the i-th value of worker w
+Apart the workers fill their own region of the list, interleaved they all write between each other's values
+*/
+func value(w, i int, interleaved bool) int {
+	if interleaved {
+		return i*workers + w
+	}
+	return w*perWorker + i
+}
+
+/*
+hammer runs workers that each insert perWorker values in random order and delete every other one again,
+while one more goroutine keeps reading, and returns the values that must be left sorted
+*/
+func hammer(interleaved bool, insert func(int), remove func(int) error, read func()) ([]int, error) {
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			random := rand.New(rand.NewPCG(uint64(w), 1))
+			for _, i := range random.Perm(perWorker) {
+				insert(value(w, i, interleaved))
+			}
+			for i := 0; i < perWorker; i += 2 {
+				if err := remove(value(w, i, interleaved)); err != nil {
+					errs <- fmt.Errorf("delete %d: %w", value(w, i, interleaved), err)
+					return
+				}
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	var reader sync.WaitGroup
+	reader.Add(1)
+	go func() {
+		defer reader.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				read()
+			}
+		}
+	}()
+
+	wg.Wait()
+	close(done)
+	reader.Wait()
+	close(errs)
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+
+	var want []int
+	for w := range workers {
+		for i := 1; i < perWorker; i += 2 {
+			want = append(want, value(w, i, interleaved))
+		}
+	}
+	slices.Sort(want)
+	return want, nil
+}
+
+// TestSyncListConcurrent shares one SyncList between writers and a reader, run it with go test -race
+func TestSyncListConcurrent(t *testing.T) {
+	for _, interleaved := range []bool{false, true} {
+		t.Run(fmt.Sprintf("interleaved=%v", interleaved), func(t *testing.T) {
+			list := linkedlist.NewSync[int]()
+			want, err := hammer(interleaved,
+				func(v int) { list.InsertInSortedList(v) },
+				list.DeleteByValue,
+				func() {
+					list.Len()
+					list.FindMax()
+					for range list.Values() {
+					}
+				})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := list.Snapshot(); !slices.Equal(got, want) {
+				t.Fatalf("got %d values %v, want %d", len(got), got, len(want))
+			}
+			if list.Len() != len(want) {
+				t.Fatalf("Len %d, want %d", list.Len(), len(want))
+			}
+		})
+	}
+}
+
+// TestHandOverHandConcurrent shares one HandOverHandList between writers and a reader, run it with go test -race
+func TestHandOverHandConcurrent(t *testing.T) {
+	for _, interleaved := range []bool{false, true} {
+		t.Run(fmt.Sprintf("interleaved=%v", interleaved), func(t *testing.T) {
+			list := linkedlist.NewHandOverHand[int]()
+			want, err := hammer(interleaved, func(v int) { list.Insert(v) }, list.Delete, func() {
+				if values := list.Snapshot(); !slices.IsSorted(values) {
+					t.Errorf("Snapshot is not sorted: %v", values)
+				}
+				list.Contains(perWorker)
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := list.Snapshot(); !slices.Equal(got, want) {
+				t.Fatalf("got %d values %v, want %d", len(got), got, len(want))
+			}
+			if list.Len() != len(want) {
+				t.Fatalf("Len %d, want %d", list.Len(), len(want))
+			}
+			if list.Delete(-1) == nil {
+				t.Fatal("Delete of a missing value succeeded")
+			}
+		})
+	}
+}
+
+// TestHandOverHandNoComparator checks that a list without a compare function fails instead of panicking
+func TestHandOverHandNoComparator(t *testing.T) {
+	lists := map[string]*linkedlist.HandOverHandList[int]{
+		"Zero":       {},
+		"NilCompare": linkedlist.NewHandOverHandFunc[int](nil),
+	}
+	for name, list := range lists {
+		t.Run(name, func(t *testing.T) {
+			if err := list.Insert(1); !errors.Is(err, linkedlist.ErrNoComparator) {
+				t.Fatalf("Insert: got %v, want %v", err, linkedlist.ErrNoComparator)
+			}
+			if err := list.Delete(1); !errors.Is(err, linkedlist.ErrNotFound) {
+				t.Fatalf("Delete: got %v, want %v", err, linkedlist.ErrNotFound)
+			}
+			if list.Contains(1) || list.Len() != 0 || len(list.Snapshot()) != 0 {
+				t.Fatalf("list without compare is not empty: %v", list.Snapshot())
+			}
+		})
+	}
+}
+
+// prefill is the number of values the lists hold while the benchmarks run, so every insert walks part of the list
+const prefill = 1000
+
+// benchmarkSorted inserts a random value and deletes it again from all goroutines at once
+func benchmarkSorted(b *testing.B, insert func(int), remove func(int) error) {
+	for i := range prefill {
+		insert(2 * i)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		random := rand.New(rand.NewPCG(rand.Uint64(), 0))
+		for pb.Next() {
+			v := 2*random.IntN(prefill) + 1
+			insert(v)
+			remove(v)
+		}
+	})
+}
+
+func BenchmarkSyncList(b *testing.B) {
+	list := linkedlist.NewSync[int]()
+	benchmarkSorted(b, func(v int) { list.InsertInSortedList(v) }, list.DeleteByValue)
+}
+
+func BenchmarkHandOverHand(b *testing.B) {
+	list := linkedlist.NewHandOverHand[int]()
+	benchmarkSorted(b, func(v int) { list.Insert(v) }, list.Delete)
+}
@@ -0,0 +1,121 @@
+package linkedlist
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"generic/diagram"
+)
+
+/*
+walk returns the nodes reachable from Head in order, each one once
+If the last node links back to an earlier node instead of nil, loop is the index of that node, otherwise loop is -1
+*/
+func (list *LinkedList[T]) walk() (nodes []*Node[T], loop int) {
+	index := make(map[*Node[T]]int)
+	for current := list.Head; current != nil; current = current.Next {
+		if i, seen := index[current]; seen {
+			return nodes, i
+		}
+		index[current] = len(nodes)
+		nodes = append(nodes, current)
+	}
+	return nodes, -1
+}
+
+// problems lists the ways Tail and size disagree with the nodes reachable from Head
+func (list *LinkedList[T]) problems(nodes []*Node[T], loop int) []string {
+	var problems []string
+	if loop >= 0 {
+		problems = append(problems, fmt.Sprintf("cycle: the last node links back to node %d", loop))
+	}
+
+	if list.Tail != nil && !slices.Contains(nodes, list.Tail) {
+		problems = append(problems, "Tail is not reachable from Head")
+	}
+	if list.Tail == nil && len(nodes) > 0 {
+		problems = append(problems, "Tail is nil on a non-empty list")
+	}
+	if list.Tail != nil && list.Tail.Next != nil && loop < 0 {
+		problems = append(problems, "Tail is not the last node")
+	}
+
+	if list.size != len(nodes) {
+		problems = append(problems, fmt.Sprintf("size is %d but %d nodes are reachable", list.size, len(nodes)))
+	}
+	return problems
+}
+
+/*
+RenderASCII draws the list as text, with Head and Tail marked above the nodes they point to:
+
+	Head          Tail
+	 v             v
+	[1] -> [2] -> [3] -> nil
+
+A cycle is drawn as an arrow from the last node back to the node it links to,
+and any disagreement between the nodes, Tail and the cached size is listed below the drawing
+*/
+func (list *LinkedList[T]) RenderASCII() string {
+	nodes, loop := list.walk()
+
+	cells := make([]string, len(nodes))
+	arrows := make([]string, len(nodes))
+	markers := make(map[int][]string)
+	for i, node := range nodes {
+		cells[i] = fmt.Sprintf("[%v]", node.Data)
+		arrows[i] = " -> "
+		if node == list.Head {
+			markers[i] = append(markers[i], "Head")
+		}
+		if node == list.Tail {
+			markers[i] = append(markers[i], "Tail")
+		}
+	}
+
+	drawing := diagram.Chain(cells, arrows, markers, " -> nil", "nil", loop)
+	return drawing + diagram.Problems(list.problems(nodes, loop))
+}
+
+/*
+ToDOT describes the list as a Graphviz digraph, render it with: dot -Tsvg list.dot -o list.svg
+Every node is a box labelled with its data, Head and Tail point to their nodes and a cycle edge is drawn in red
+*/
+func (list *LinkedList[T]) ToDOT() string {
+	nodes, loop := list.walk()
+
+	var dot strings.Builder
+	dot.WriteString("digraph LinkedList {\n\trankdir=LR;\n\tnode [shape=box];\n")
+	for i, node := range nodes {
+		fmt.Fprintf(&dot, "\tn%d [label=%q];\n", i, fmt.Sprint(node.Data))
+	}
+
+	for i := 1; i < len(nodes); i++ {
+		fmt.Fprintf(&dot, "\tn%d -> n%d;\n", i-1, i)
+	}
+
+	switch {
+	case loop >= 0:
+		fmt.Fprintf(&dot, "\tn%d -> n%d [color=red, label=\"cycle\"];\n", len(nodes)-1, loop)
+	case len(nodes) > 0:
+		fmt.Fprintf(&dot, "\tnil [shape=plaintext];\n\tn%d -> nil;\n", len(nodes)-1)
+	}
+
+	pointers := []struct {
+		name string
+		node *Node[T]
+	}{{"Head", list.Head}, {"Tail", list.Tail}}
+	for _, pointer := range pointers {
+		fmt.Fprintf(&dot, "\t%s [shape=plaintext];\n", pointer.name)
+		if i := slices.Index(nodes, pointer.node); i >= 0 {
+			fmt.Fprintf(&dot, "\t%s -> n%d [style=dashed];\n", pointer.name, i)
+		}
+	}
+
+	for _, problem := range list.problems(nodes, loop) {
+		fmt.Fprintf(&dot, "\t// %s\n", problem)
+	}
+	dot.WriteString("}\n")
+	return dot.String()
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+
+	"generic/linkedlist"
+	"generic/persistent"
+)
+
+// persistentDemo keeps every version of a config list, each edit shares the untouched suffix with the version before it
+func persistentDemo() {
+	v1 := persistent.Of("debug=false", "port=8080", "workers=4")
+	v2, _ := v1.Update(0, "debug=true")
+	v3 := v2.Cons("env=staging")
+	v4, _ := v3.DeleteValue("port=8080")
+
+	for i, version := range []persistent.List[string]{v1, v2, v3, v4} {
+		fmt.Printf("Config v%d : %v \n", i+1, version)
+	}
+
+	lengths := persistent.Map(v4, func(entry string) int { return len(entry) })
+	total := persistent.Fold(lengths, 0, func(sum, n int) int { return sum + n })
+	fmt.Println("Config v4 Bytes :", total)
+
+	mutable := v4.ToLinkedList()
+	mutable.Reverse()
+	fmt.Println("Reversed Copy :", persistent.FromLinkedList(mutable), "Original :", v4)
+
+	scores := linkedlist.New[int]()
+	for _, score := range []int{5, 8, 3, 9} {
+		scores.InsertAtBack(score)
+	}
+	passed := persistent.FromLinkedList(scores).Filter(func(score int) bool { return score >= 5 })
+	fmt.Println("Passed :", passed)
+}
@@ -0,0 +1,10 @@
+package persistent
+
+import "errors"
+
+// Sentinel errors returned by the List operations
+var (
+	ErrNotFound        = errors.New("persistent: no record found")
+	ErrIndexOutOfRange = errors.New("persistent: index out of range")
+	ErrEmpty           = errors.New("persistent: list is empty")
+)
@@ -0,0 +1,292 @@
+/*
+Package persistent provides an immutable singly linked list with structural sharing
+No operation ever changes a node: Cons, Tail, Insert, Delete and Update return a new version of the list
+and leave the old one untouched, so any version can be handed to other goroutines without locking
+A new version copies only the nodes in front of the change and shares the unchanged suffix with the old version,
+Cons and Tail are O(1) and an edit at index i costs O(i) time and memory
+*/
+package persistent
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"generic/linkedlist"
+)
+
+// node is an immutable cell, it is never changed after it is created
+type node[T comparable] struct {
+	data T
+	next *node[T]
+}
+
+/*
+List is an immutable linked list, the zero value is an empty list ready to use
+It is a small value (a pointer and a length), so it is passed and stored by value
+*/
+type List[T comparable] struct {
+	head *node[T]
+	size int
+}
+
+// Of returns a list holding values in order
+func Of[T comparable](values ...T) List[T] {
+	var head *node[T]
+	for i := len(values) - 1; i >= 0; i-- {
+		head = &node[T]{data: values[i], next: head}
+	}
+	return List[T]{head: head, size: len(values)}
+}
+
+// FromSeq returns a list holding the values of seq in order
+func FromSeq[T comparable](seq iter.Seq[T]) List[T] {
+	var values []T
+	for v := range seq {
+		values = append(values, v)
+	}
+	return Of(values...)
+}
+
+// FromLinkedList returns a list holding a copy of the values of a LinkedList, from Head to Tail
+func FromLinkedList[T comparable](list *linkedlist.LinkedList[T]) List[T] {
+	return FromSeq(list.Values())
+}
+
+// ToLinkedList returns a new mutable LinkedList holding the values, it has no Compare function
+func (list List[T]) ToLinkedList() *linkedlist.LinkedList[T] {
+	return linkedlist.FromSeq(list.Values())
+}
+
+// Len returns the number of values in O(1)
+func (list List[T]) Len() int {
+	return list.size
+}
+
+// IsEmpty reports whether the list holds no values
+func (list List[T]) IsEmpty() bool {
+	return list.head == nil
+}
+
+// Cons returns a new list with data in front of the receiver, it shares every node of the receiver
+func (list List[T]) Cons(data T) List[T] {
+	return List[T]{head: &node[T]{data: data, next: list.head}, size: list.size + 1}
+}
+
+// Head returns the first value, or ErrEmpty
+func (list List[T]) Head() (T, error) {
+	if list.head == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.head.data, nil
+}
+
+// Tail returns the list without its first value in O(1), or ErrEmpty
+func (list List[T]) Tail() (List[T], error) {
+	if list.head == nil {
+		return list, ErrEmpty
+	}
+	return List[T]{head: list.head.next, size: list.size - 1}, nil
+}
+
+// Get returns the value at index, or ErrIndexOutOfRange
+func (list List[T]) Get(index int) (T, error) {
+	if index < 0 || index >= list.size {
+		var zero T
+		return zero, ErrIndexOutOfRange
+	}
+
+	current := list.head
+	for i := 0; i < index; i++ {
+		current = current.next
+	}
+	return current.data, nil
+}
+
+/*
+rebuild copies the first n values of the list in front of rest and returns the new head
+It is the single place where new versions copy nodes, everything from rest on is shared
+*/
+func (list List[T]) rebuild(n int, rest *node[T]) *node[T] {
+	prefix := make([]T, 0, n)
+	for current := list.head; len(prefix) < n; current = current.next {
+		prefix = append(prefix, current.data)
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		rest = &node[T]{data: prefix[i], next: rest}
+	}
+	return rest
+}
+
+// at returns the node at index, the caller checks the bounds
+func (list List[T]) at(index int) *node[T] {
+	current := list.head
+	for i := 0; i < index; i++ {
+		current = current.next
+	}
+	return current
+}
+
+/*
+Insert returns a new list with data at index, where index may be equal to Len to append
+The nodes before index are copied, the nodes from index on are shared
+If index is out of range, it returns ErrIndexOutOfRange
+*/
+func (list List[T]) Insert(index int, data T) (List[T], error) {
+	if index < 0 || index > list.size {
+		return list, ErrIndexOutOfRange
+	}
+
+	rest := &node[T]{data: data, next: list.at(index)}
+	return List[T]{head: list.rebuild(index, rest), size: list.size + 1}, nil
+}
+
+/*
+Update returns a new list with the value at index replaced by data
+The nodes before index are copied, the nodes after it are shared
+If index is out of range, it returns ErrIndexOutOfRange
+*/
+func (list List[T]) Update(index int, data T) (List[T], error) {
+	if index < 0 || index >= list.size {
+		return list, ErrIndexOutOfRange
+	}
+
+	rest := &node[T]{data: data, next: list.at(index).next}
+	return List[T]{head: list.rebuild(index, rest), size: list.size}, nil
+}
+
+/*
+Delete returns a new list without the value at index
+The nodes before index are copied, the nodes after it are shared
+If index is out of range, it returns ErrIndexOutOfRange
+*/
+func (list List[T]) Delete(index int) (List[T], error) {
+	if index < 0 || index >= list.size {
+		return list, ErrIndexOutOfRange
+	}
+
+	rest := list.at(index).next
+	return List[T]{head: list.rebuild(index, rest), size: list.size - 1}, nil
+}
+
+// DeleteValue returns a new list without the first occurrence of data, or ErrNotFound
+func (list List[T]) DeleteValue(data T) (List[T], error) {
+	index := list.IndexOf(data)
+	if index < 0 {
+		return list, ErrNotFound
+	}
+	return list.Delete(index)
+}
+
+// IndexOf returns the index of the first occurrence of data, or -1
+func (list List[T]) IndexOf(data T) int {
+	index := 0
+	for current := list.head; current != nil; current = current.next {
+		if current.data == data {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// Append returns a new list with the values of other after the receiver, the receiver is copied and other is shared
+func (list List[T]) Append(other List[T]) List[T] {
+	return List[T]{head: list.rebuild(list.size, other.head), size: list.size + other.size}
+}
+
+// Reverse returns a new list with the values in reverse order, nothing can be shared
+func (list List[T]) Reverse() List[T] {
+	var reversed List[T]
+	for current := list.head; current != nil; current = current.next {
+		reversed = reversed.Cons(current.data)
+	}
+	return reversed
+}
+
+/*
+Filter returns a new list with only the values for which keep returns true
+The longest suffix where every value is kept is shared with the receiver
+*/
+func (list List[T]) Filter(keep func(data T) bool) List[T] {
+	// shared is the first node of the current run of kept values, a dropped value ends the run
+	var kept []T
+	var shared *node[T]
+	sharedSize := 0
+	for current := list.head; current != nil; current = current.next {
+		if !keep(current.data) {
+			shared, sharedSize = nil, 0
+			continue
+		}
+
+		if shared == nil {
+			shared = current
+		}
+		sharedSize++
+		kept = append(kept, current.data)
+	}
+
+	result := List[T]{head: shared, size: sharedSize}
+	for i := len(kept) - sharedSize - 1; i >= 0; i-- {
+		result = result.Cons(kept[i])
+	}
+	return result
+}
+
+// Map returns a new list holding fn applied to every value, in order
+func Map[T, U comparable](list List[T], fn func(data T) U) List[U] {
+	values := make([]U, 0, list.size)
+	for current := list.head; current != nil; current = current.next {
+		values = append(values, fn(current.data))
+	}
+	return Of(values...)
+}
+
+// Fold combines the values from the first to the last, starting from initial
+func Fold[T comparable, A any](list List[T], initial A, fn func(accumulator A, data T) A) A {
+	accumulator := initial
+	for current := list.head; current != nil; current = current.next {
+		accumulator = fn(accumulator, current.data)
+	}
+	return accumulator
+}
+
+// All returns an iterator over the positions and values, from the first to the last
+func (list List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for current := list.head; current != nil; current = current.next {
+			if !yield(index, current.data) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Values returns an iterator over the values, from the first to the last
+func (list List[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := list.head; current != nil; current = current.next {
+			if !yield(current.data) {
+				return
+			}
+		}
+	}
+}
+
+// String formats the list as [a b c]
+func (list List[T]) String() string {
+	var text strings.Builder
+	text.WriteString("[")
+	for i, data := range list.All() {
+		if i > 0 {
+			text.WriteString(" ")
+		}
+		fmt.Fprint(&text, data)
+	}
+	text.WriteString("]")
+	return text.String()
+}
@@ -0,0 +1,168 @@
+package persistent
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// version is one list of the history together with the values it must hold forever
+type version struct {
+	list List[int]
+	want []int
+}
+
+// check fails t if the list does not hold want, walking the nodes, the iterators and the indexed reads
+func (v version) check(t *testing.T) {
+	t.Helper()
+
+	if got := slices.Collect(v.list.Values()); !slices.Equal(got, v.want) {
+		t.Fatalf("got %v, want %v", got, v.want)
+	}
+	if v.list.Len() != len(v.want) || v.list.IsEmpty() != (len(v.want) == 0) {
+		t.Fatalf("%v: Len %d, IsEmpty %t, want %d values", v.want, v.list.Len(), v.list.IsEmpty(), len(v.want))
+	}
+	for i, want := range v.want {
+		if got, err := v.list.Get(i); err != nil || got != want {
+			t.Fatalf("%v: Get(%d) = %d, %v", v.want, i, got, err)
+		}
+	}
+}
+
+// shares reports whether the last n nodes of a and b are the same nodes
+func shares(a, b List[int], n int) bool {
+	if n == 0 {
+		return true
+	}
+	return a.at(a.size-n) == b.at(b.size-n)
+}
+
+/*
+TestVersions builds a history of versions, every step edits a random older version into a new one
+After every step every version in the history must still hold its values, and the new version must share
+the unchanged suffix of the version it came from
+*/
+func TestVersions(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+	history := []version{{List[int]{}, nil}}
+
+	for step := range 2000 {
+		from := history[random.IntN(len(history))]
+		n := len(from.want)
+		index := random.IntN(n + 2)
+		data := random.IntN(10)
+
+		var next version
+		var err error
+		shared := 0
+		switch op := random.IntN(9); op {
+		case 0:
+			next = version{from.list.Cons(data), append([]int{data}, from.want...)}
+			shared = n
+		case 1:
+			next.list, err = from.list.Tail()
+			if n == 0 {
+				if !errors.Is(err, ErrEmpty) {
+					t.Fatalf("step %d: Tail of the empty list returned %v", step, err)
+				}
+				continue
+			}
+			next.want, shared = from.want[1:], n-1
+		case 2:
+			next.list, err = from.list.Insert(index, data)
+			if index > n {
+				if !errors.Is(err, ErrIndexOutOfRange) {
+					t.Fatalf("step %d: Insert(%d) on %d values returned %v", step, index, n, err)
+				}
+				continue
+			}
+			next.want, shared = slices.Insert(slices.Clone(from.want), index, data), n-index
+		case 3:
+			next.list, err = from.list.Update(index, data)
+			if index >= n {
+				if !errors.Is(err, ErrIndexOutOfRange) {
+					t.Fatalf("step %d: Update(%d) on %d values returned %v", step, index, n, err)
+				}
+				continue
+			}
+			next.want = slices.Clone(from.want)
+			next.want[index] = data
+			shared = n - index - 1
+		case 4:
+			next.list, err = from.list.Delete(index)
+			if index >= n {
+				if !errors.Is(err, ErrIndexOutOfRange) {
+					t.Fatalf("step %d: Delete(%d) on %d values returned %v", step, index, n, err)
+				}
+				continue
+			}
+			next.want, shared = slices.Delete(slices.Clone(from.want), index, index+1), n-index-1
+		case 5:
+			next.list, err = from.list.DeleteValue(data)
+			i := slices.Index(from.want, data)
+			if i < 0 {
+				if !errors.Is(err, ErrNotFound) {
+					t.Fatalf("step %d: DeleteValue(%d) of %v returned %v", step, data, from.want, err)
+				}
+				continue
+			}
+			next.want, shared = slices.Delete(slices.Clone(from.want), i, i+1), n-i-1
+		case 6:
+			other := history[random.IntN(len(history))]
+			next = version{from.list.Append(other.list), slices.Concat(from.want, other.want)}
+			if !shares(next.list, other.list, len(other.want)) {
+				t.Fatalf("step %d: Append copied its argument", step)
+			}
+		case 7:
+			next = version{from.list.Reverse(), slices.Clone(from.want)}
+			slices.Reverse(next.want)
+		case 8:
+			keep := func(v int) bool { return v%3 != data%3 }
+			next = version{from.list.Filter(keep), nil}
+			for _, v := range from.want {
+				if keep(v) {
+					next.want = append(next.want, v)
+				}
+			}
+			// Only the kept values after the last dropped one can be shared
+			for shared < n && keep(from.want[n-shared-1]) {
+				shared++
+			}
+		}
+		if err != nil {
+			t.Fatalf("step %d: %v", step, err)
+		}
+
+		next.check(t)
+		if !shares(next.list, from.list, shared) {
+			t.Fatalf("step %d: %v from %v does not share its last %d nodes", step, next.want, from.want, shared)
+		}
+		history = append(history, next)
+		for _, v := range history {
+			v.check(t)
+		}
+	}
+}
+
+func TestFunctions(t *testing.T) {
+	list := Of(1, 2, 3, 4)
+	if got := Map(list, func(v int) string { return string(rune('a' + v)) }); got.String() != "[b c d e]" {
+		t.Fatalf("Map: got %v", got)
+	}
+	if sum := Fold(list, 0, func(sum, v int) int { return sum + v }); sum != 10 {
+		t.Fatalf("Fold: got %d, want 10", sum)
+	}
+	if list.IndexOf(3) != 2 || list.IndexOf(5) != -1 {
+		t.Fatalf("IndexOf: got %d and %d", list.IndexOf(3), list.IndexOf(5))
+	}
+	if head, err := (List[int]{}).Head(); err != ErrEmpty {
+		t.Fatalf("Head of the empty list: %d, %v", head, err)
+	}
+
+	mutable := list.ToLinkedList()
+	mutable.PushBack(5)
+	if got := FromLinkedList(mutable); got.String() != "[1 2 3 4 5]" || list.String() != "[1 2 3 4]" {
+		t.Fatalf("round trip through LinkedList: got %v, the original is now %v", got, list)
+	}
+}
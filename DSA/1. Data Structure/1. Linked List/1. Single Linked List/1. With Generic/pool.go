@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+
+	"generic/linkedlist"
+)
+
+// poolDemo shows a pooled list and a slab list reusing their nodes, the allocation benchmarks are in linkedlist/pool_test.go
+func poolDemo() {
+	pool := linkedlist.NewNodePool[int](0)
+	pooled := linkedlist.New[int]().WithNodePool(pool)
+	for i := range 8 {
+		pooled.PushBack(i)
+	}
+	for range 5 {
+		pooled.PopFront()
+	}
+	fmt.Println("NodePool Free After Pops :", pool.Free())
+	pooled.PushBack(8)
+	fmt.Println("NodePool Free After Push :", pool.Free())
+
+	slab := linkedlist.NewSlab[int](4)
+	for i := range 4 {
+		slab.PushBack(i)
+	}
+	slab.PopFront()
+	slab.PushBack(4)
+	fmt.Println("SlabList Cap After Reuse :", slab.Cap())
+	slab.Print()
+}
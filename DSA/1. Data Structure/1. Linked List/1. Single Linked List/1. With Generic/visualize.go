@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"generic/linkedlist"
+)
+
+// visualizeDemo replays a few operations as ASCII frames and draws a list with a cycle
+func visualizeDemo() {
+	list := linkedlist.New[int]()
+	recorder := linkedlist.NewRecorder(list)
+	recorder.Record("InsertAtBack(6)", func() error { list.InsertAtBack(6); return nil })
+	recorder.Record("InsertAtFront(2)", func() error { list.InsertAtFront(2); return nil })
+	recorder.Record("InsertBeforeValue(6, 4)", func() error { return list.InsertBeforeValue(6, 4) })
+	recorder.Record("InsertBeforeValue(9, 1)", func() error { return list.InsertBeforeValue(9, 1) })
+	recorder.Record("InsertAtBack(4)", func() error { list.InsertAtBack(4); return nil })
+	recorder.Record("DeleteAllByValue(4)", func() error { _, err := list.DeleteAllByValue(4); return err })
+	recorder.Replay(os.Stdout)
+	fmt.Println("Frames :", len(recorder.Frames()))
+
+	looped := linkedlist.New[int]()
+	for i := 1; i <= 4; i++ {
+		looped.PushBack(i)
+	}
+	looped.Tail.Next = looped.Head.Next
+	fmt.Print(looped.RenderASCII())
+	fmt.Print(looped.ToDOT())
+}
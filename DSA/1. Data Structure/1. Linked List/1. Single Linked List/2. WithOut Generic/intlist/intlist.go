@@ -0,0 +1,24 @@
+/*
+Package intlist is the int linked list, it is an instantiation of the generic linkedlist package
+It used to be a separate copy of every method written for int, now the generic code is the single implementation
+and the aliases below keep the old names, so code written against Node and LinkedList still compiles
+The conformance suite in generic/linkedlist/listtest checks the shared implementation against a reference model
+*/
+package intlist
+
+import "generic/linkedlist"
+
+// Node represents a single node in the linked list
+type Node = linkedlist.Node[int]
+
+// LinkedList represents a linked list of ints
+type LinkedList = linkedlist.LinkedList[int]
+
+/*
+New returns an empty list that compares ints in ascending order
+The zero value LinkedList{} also works, but without a Compare function the ordered operations
+(InsertInSortedList, FindMax, FindMin, Sort) return linkedlist.ErrNoComparator
+*/
+func New() *LinkedList {
+	return linkedlist.New[int]()
+}
@@ -0,0 +1,22 @@
+package intlist_test
+
+import (
+	"testing"
+
+	"generic/linkedlist/listtest"
+	"withoutgeneric/intlist"
+)
+
+// zero returns the zero value list, which has no Compare function
+func zero() *intlist.LinkedList {
+	return &intlist.LinkedList{}
+}
+
+func TestList(t *testing.T) {
+	t.Run("New", func(t *testing.T) { listtest.Run(t, intlist.New, listtest.LinkedListErrors) })
+	t.Run("Zero", func(t *testing.T) { listtest.Run(t, zero, listtest.LinkedListErrors) })
+}
+
+func FuzzList(f *testing.F) {
+	listtest.Fuzz(f, intlist.New, listtest.LinkedListErrors)
+}
@@ -0,0 +1,620 @@
+/*
+Package doublylinkedlist provides a generic doubly linked list that can be used as a deque
+Every node links to both its Prev and its Next node, and the list keeps a head and a tail sentinel,
+so inserting or removing at either end or next to a known node is O(1) and needs no nil checks
+The value based API mirrors the singly linked list (InsertInSortedList, DeleteAllByValue, Reverse, FindMiddle ...)
+*/
+package doublylinkedlist
+
+import (
+	"cmp"
+	"fmt"
+)
+
+/*
+Node represents a single node in the doubly linked list
+The links are unexported so the sentinels can never leak, use Next and Prev to walk the list
+*/
+type Node[T comparable] struct {
+	Data T
+	next *Node[T]
+	prev *Node[T]
+	list *DoublyLinkedList[T]
+}
+
+// Next returns the next node, or nil if node is the last one
+func (node *Node[T]) Next() *Node[T] {
+	if next := node.next; node.list != nil && next != node.list.tail {
+		return next
+	}
+	return nil
+}
+
+// Prev returns the previous node, or nil if node is the first one
+func (node *Node[T]) Prev() *Node[T] {
+	if prev := node.prev; node.list != nil && prev != node.list.head {
+		return prev
+	}
+	return nil
+}
+
+/*
+DoublyLinkedList represents a doubly linked list
+head and tail are sentinel nodes that never hold data, the real nodes live between them
+Compare is optional and only needed by the ordered operations (InsertInSortedList, FindMax, FindMin)
+The zero value is an empty list ready to use
+*/
+type DoublyLinkedList[T comparable] struct {
+	head    *Node[T]
+	tail    *Node[T]
+	Compare func(a, b T) int
+	size    int
+}
+
+// New returns an empty list of an ordered type that uses cmp.Compare for the ordered operations
+func New[T cmp.Ordered]() *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{Compare: cmp.Compare[T]}
+}
+
+// NewFunc returns an empty list that uses the given compare function for the ordered operations
+func NewFunc[T comparable](compare func(a, b T) int) *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{Compare: compare}
+}
+
+// lazyInit creates the sentinels the first time the list is used, so the zero value works
+func (list *DoublyLinkedList[T]) lazyInit() {
+	if list.head != nil {
+		return
+	}
+
+	list.head = &Node[T]{}
+	list.tail = &Node[T]{}
+	list.head.next = list.tail
+	list.tail.prev = list.head
+}
+
+/*
+insertBetween links a new node holding data between prev and next and returns it
+It is the single place where size is updated on insertion
+*/
+func (list *DoublyLinkedList[T]) insertBetween(data T, prev, next *Node[T]) *Node[T] {
+	node := &Node[T]{Data: data, prev: prev, next: next, list: list}
+	prev.next = node
+	next.prev = node
+	list.size++
+	return node
+}
+
+/*
+unlink removes node from the list and clears its links
+It is the single place where size is updated on deletion
+*/
+func (list *DoublyLinkedList[T]) unlink(node *Node[T]) {
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	node.next = nil
+	node.prev = nil
+	node.list = nil
+	list.size--
+}
+
+// owns reports whether node is a real node of the list
+func (list *DoublyLinkedList[T]) owns(node *Node[T]) bool {
+	return node != nil && node.list == list
+}
+
+/*
+nodeAt returns the node at the given index, or nil if the index is out of range
+It walks from whichever end is closer, so it needs at most n/2 steps
+*/
+func (list *DoublyLinkedList[T]) nodeAt(index int) *Node[T] {
+	if index < 0 || index >= list.size {
+		return nil
+	}
+
+	if index < list.size/2 {
+		current := list.head.next
+		for i := 0; i < index; i++ {
+			current = current.next
+		}
+		return current
+	}
+
+	current := list.tail.prev
+	for i := list.size - 1; i > index; i-- {
+		current = current.prev
+	}
+	return current
+}
+
+// find returns the first node holding data, or nil
+func (list *DoublyLinkedList[T]) find(data T) *Node[T] {
+	list.lazyInit()
+	for current := list.head.next; current != list.tail; current = current.next {
+		if current.Data == data {
+			return current
+		}
+	}
+	return nil
+}
+
+// Len returns the number of nodes in O(1)
+func (list *DoublyLinkedList[T]) Len() int {
+	return list.size
+}
+
+// Length returns the number of nodes, it is kept for parity with the singly linked list
+func (list *DoublyLinkedList[T]) Length() int {
+	return list.size
+}
+
+// FrontNode returns the first node, or nil if the list is empty
+func (list *DoublyLinkedList[T]) FrontNode() *Node[T] {
+	if list.size == 0 {
+		return nil
+	}
+	return list.head.next
+}
+
+// BackNode returns the last node, or nil if the list is empty
+func (list *DoublyLinkedList[T]) BackNode() *Node[T] {
+	if list.size == 0 {
+		return nil
+	}
+	return list.tail.prev
+}
+
+// Front returns the data of the first node in O(1), or ErrEmpty
+func (list *DoublyLinkedList[T]) Front() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.head.next.Data, nil
+}
+
+// Back returns the data of the last node in O(1), or ErrEmpty
+func (list *DoublyLinkedList[T]) Back() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.tail.prev.Data, nil
+}
+
+// PushFront inserts data at the front of the list in O(1) and returns its node
+func (list *DoublyLinkedList[T]) PushFront(data T) *Node[T] {
+	list.lazyInit()
+	return list.insertBetween(data, list.head, list.head.next)
+}
+
+// PushBack inserts data at the back of the list in O(1) and returns its node
+func (list *DoublyLinkedList[T]) PushBack(data T) *Node[T] {
+	list.lazyInit()
+	return list.insertBetween(data, list.tail.prev, list.tail)
+}
+
+// PopFront removes the first node in O(1) and returns its data, or ErrEmpty
+func (list *DoublyLinkedList[T]) PopFront() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	node := list.head.next
+	list.unlink(node)
+	return node.Data, nil
+}
+
+// PopBack removes the last node in O(1) and returns its data, or ErrEmpty
+func (list *DoublyLinkedList[T]) PopBack() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	node := list.tail.prev
+	list.unlink(node)
+	return node.Data, nil
+}
+
+/*
+InsertBefore inserts data right before mark in O(1) and returns the new node
+If mark does not belong to the list, it returns ErrInvalidNode
+*/
+func (list *DoublyLinkedList[T]) InsertBefore(data T, mark *Node[T]) (*Node[T], error) {
+	if !list.owns(mark) {
+		return nil, ErrInvalidNode
+	}
+	return list.insertBetween(data, mark.prev, mark), nil
+}
+
+/*
+InsertAfter inserts data right after mark in O(1) and returns the new node
+If mark does not belong to the list, it returns ErrInvalidNode
+*/
+func (list *DoublyLinkedList[T]) InsertAfter(data T, mark *Node[T]) (*Node[T], error) {
+	if !list.owns(mark) {
+		return nil, ErrInvalidNode
+	}
+	return list.insertBetween(data, mark, mark.next), nil
+}
+
+/*
+Remove removes node from the list in O(1) and returns its data
+If node does not belong to the list, it returns ErrInvalidNode
+*/
+func (list *DoublyLinkedList[T]) Remove(node *Node[T]) (T, error) {
+	if !list.owns(node) {
+		var zero T
+		return zero, ErrInvalidNode
+	}
+
+	list.unlink(node)
+	return node.Data, nil
+}
+
+/*
+MoveToFront moves node to the front of the list in O(1)
+If node does not belong to the list, it returns ErrInvalidNode
+*/
+func (list *DoublyLinkedList[T]) MoveToFront(node *Node[T]) error {
+	if !list.owns(node) {
+		return ErrInvalidNode
+	}
+
+	if list.head.next == node {
+		return nil
+	}
+
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	node.prev = list.head
+	node.next = list.head.next
+	list.head.next.prev = node
+	list.head.next = node
+	return nil
+}
+
+/*
+MoveToBack moves node to the back of the list in O(1)
+If node does not belong to the list, it returns ErrInvalidNode
+*/
+func (list *DoublyLinkedList[T]) MoveToBack(node *Node[T]) error {
+	if !list.owns(node) {
+		return ErrInvalidNode
+	}
+
+	if list.tail.prev == node {
+		return nil
+	}
+
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	node.next = list.tail
+	node.prev = list.tail.prev
+	list.tail.prev.next = node
+	list.tail.prev = node
+	return nil
+}
+
+/*
+Print prints the doubly linked list
+It starts from the first node and prints the data of each node until it reaches the tail sentinel
+*/
+func (list *DoublyLinkedList[T]) Print() {
+	list.lazyInit()
+	for current := list.head.next; current != list.tail; current = current.next {
+		fmt.Println("Data is :", current.Data)
+	}
+}
+
+/*
+PrintReverse prints the doubly linked list from the last node to the first
+Thanks to the Prev links it needs neither defer nor recursion
+*/
+func (list *DoublyLinkedList[T]) PrintReverse() {
+	list.lazyInit()
+	for current := list.tail.prev; current != list.head; current = current.prev {
+		fmt.Println("Data is :", current.Data)
+	}
+}
+
+// InsertAtBack inserts a new node at the end of the list, it is the same as PushBack
+func (list *DoublyLinkedList[T]) InsertAtBack(data T) {
+	list.PushBack(data)
+}
+
+// InsertAtFront inserts a new node at the front of the list, it is the same as PushFront
+func (list *DoublyLinkedList[T]) InsertAtFront(data T) {
+	list.PushFront(data)
+}
+
+/*
+InsertAfterValue inserts a new node after the first node holding afterValue
+If the list is empty, it returns ErrEmpty
+If no node holds afterValue, it returns ErrNotFound
+*/
+func (list *DoublyLinkedList[T]) InsertAfterValue(afterValue, data T) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	node := list.find(afterValue)
+	if node == nil {
+		return ErrNotFound
+	}
+
+	list.insertBetween(data, node, node.next)
+	return nil
+}
+
+/*
+InsertBeforeValue inserts a new node before the first node holding beforeValue
+If the list is empty, it returns ErrEmpty
+If no node holds beforeValue, it returns ErrNotFound
+*/
+func (list *DoublyLinkedList[T]) InsertBeforeValue(beforeValue, data T) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	node := list.find(beforeValue)
+	if node == nil {
+		return ErrNotFound
+	}
+
+	list.insertBetween(data, node.prev, node)
+	return nil
+}
+
+/*
+InsertInSortedList inserts a new node in a sorted doubly linked list
+It iterates from the front to find the first node greater than data and inserts the new node before it
+Equal values are inserted after the existing ones, like the singly linked list does
+If the list has no Compare function, it returns ErrNoComparator
+*/
+func (list *DoublyLinkedList[T]) InsertInSortedList(data T) error {
+	if list.Compare == nil {
+		return ErrNoComparator
+	}
+
+	list.lazyInit()
+	current := list.head.next
+	for current != list.tail && list.Compare(current.Data, data) <= 0 {
+		current = current.next
+	}
+
+	list.insertBetween(data, current.prev, current)
+	return nil
+}
+
+/*
+InsertAtSpecficPosition inserts a new node so that it ends up at the given position
+Position 0 inserts at the front and position Len() inserts at the back
+If the position is negative or greater than the length of the list, it returns ErrIndexOutOfRange
+*/
+func (list *DoublyLinkedList[T]) InsertAtSpecficPosition(data T, position int) error {
+	if position < 0 || position > list.size {
+		return ErrIndexOutOfRange
+	}
+
+	if position == list.size {
+		list.PushBack(data)
+		return nil
+	}
+
+	node := list.nodeAt(position)
+	list.insertBetween(data, node.prev, node)
+	return nil
+}
+
+/*
+UpdateValueByOldValue updates the first node holding oldValue to newValue
+If the list is empty, it returns ErrEmpty
+If no node holds oldValue, it returns ErrNotFound
+*/
+func (list *DoublyLinkedList[T]) UpdateValueByOldValue(oldValue, newValue T) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	node := list.find(oldValue)
+	if node == nil {
+		return ErrNotFound
+	}
+
+	node.Data = newValue
+	return nil
+}
+
+/*
+UpdateAllValueByOldValue updates every node holding oldValue to newValue
+It returns the number of updated nodes, ErrEmpty if the list is empty and ErrNotFound if nothing matched
+*/
+func (list *DoublyLinkedList[T]) UpdateAllValueByOldValue(oldValue, newValue T) (int, error) {
+	if list.size == 0 {
+		return 0, ErrEmpty
+	}
+
+	updated := 0
+	for current := list.head.next; current != list.tail; current = current.next {
+		if current.Data == oldValue {
+			current.Data = newValue
+			updated++
+		}
+	}
+
+	if updated == 0 {
+		return 0, ErrNotFound
+	}
+
+	return updated, nil
+}
+
+/*
+UpdateByPosition updates the value of the node at the given position
+If the list is empty, it returns ErrEmpty
+If the position is negative or not smaller than the length of the list, it returns ErrIndexOutOfRange
+*/
+func (list *DoublyLinkedList[T]) UpdateByPosition(data T, position int) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	node := list.nodeAt(position)
+	if node == nil {
+		return ErrIndexOutOfRange
+	}
+
+	node.Data = data
+	return nil
+}
+
+/*
+DeleteByValue deletes the first node holding data
+If the list is empty, it returns ErrEmpty
+If no node holds data, it returns ErrNotFound
+*/
+func (list *DoublyLinkedList[T]) DeleteByValue(data T) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	node := list.find(data)
+	if node == nil {
+		return ErrNotFound
+	}
+
+	list.unlink(node)
+	return nil
+}
+
+/*
+DeleteByIndex deletes the node at the given index
+If the list is empty, it returns ErrEmpty
+If the index is negative or not smaller than the length of the list, it returns ErrIndexOutOfRange
+*/
+func (list *DoublyLinkedList[T]) DeleteByIndex(index int) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	node := list.nodeAt(index)
+	if node == nil {
+		return ErrIndexOutOfRange
+	}
+
+	list.unlink(node)
+	return nil
+}
+
+/*
+DeleteAllByValue deletes every node holding value
+Each node knows its Prev, so no trailing pointer is needed while iterating
+It returns the number of deleted nodes, ErrEmpty if the list is empty and ErrNotFound if nothing matched
+*/
+func (list *DoublyLinkedList[T]) DeleteAllByValue(value T) (int, error) {
+	if list.size == 0 {
+		return 0, ErrEmpty
+	}
+
+	deleted := 0
+	current := list.head.next
+	for current != list.tail {
+		next := current.next
+		if current.Data == value {
+			list.unlink(current)
+			deleted++
+		}
+		current = next
+	}
+
+	if deleted == 0 {
+		return 0, ErrNotFound
+	}
+
+	return deleted, nil
+}
+
+// FindIndexByValue returns the position of the first node holding data, or ErrNotFound
+func (list *DoublyLinkedList[T]) FindIndexByValue(data T) (int, error) {
+	list.lazyInit()
+	index := 0
+	for current := list.head.next; current != list.tail; current = current.next {
+		if current.Data == data {
+			return index, nil
+		}
+		index++
+	}
+
+	return -1, ErrNotFound
+}
+
+// FindMax returns the largest value in the list, or ErrEmpty / ErrNoComparator
+func (list *DoublyLinkedList[T]) FindMax() (T, error) {
+	return list.extreme(1)
+}
+
+// FindMin returns the smallest value in the list, or ErrEmpty / ErrNoComparator
+func (list *DoublyLinkedList[T]) FindMin() (T, error) {
+	return list.extreme(-1)
+}
+
+// extreme returns the value v for which Compare(v, other) has the given sign against every other value
+func (list *DoublyLinkedList[T]) extreme(sign int) (T, error) {
+	var zero T
+	if list.Compare == nil {
+		return zero, ErrNoComparator
+	}
+
+	if list.size == 0 {
+		return zero, ErrEmpty
+	}
+
+	best := list.head.next.Data
+	for current := list.head.next.next; current != list.tail; current = current.next {
+		if list.Compare(current.Data, best)*sign > 0 {
+			best = current.Data
+		}
+	}
+
+	return best, nil
+}
+
+/*
+FindMiddle returns the value of the middle node, or ErrEmpty
+Two pointers walk towards each other from both ends until they meet or cross
+For an even number of nodes it returns the second of the two middle nodes, like the singly linked list
+*/
+func (list *DoublyLinkedList[T]) FindMiddle() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	front := list.head.next
+	back := list.tail.prev
+	for front != back && front.prev != back {
+		front = front.next
+		back = back.prev
+	}
+
+	return front.Data, nil
+}
+
+/*
+Reverse reverses the doubly linked list in place
+It swaps the Next and Prev links of every node, including the sentinels, and then swaps the sentinels
+*/
+func (list *DoublyLinkedList[T]) Reverse() {
+	if list.size < 2 {
+		return
+	}
+
+	for current := list.head; current != nil; current = current.prev {
+		current.next, current.prev = current.prev, current.next
+	}
+
+	list.head, list.tail = list.tail, list.head
+}
@@ -0,0 +1,34 @@
+package doublylinkedlist_test
+
+import (
+	"testing"
+
+	"double/doublylinkedlist"
+	"generic/linkedlist/listtest"
+)
+
+// errs are the sentinel errors of the doublylinkedlist package, in the shape listtest expects
+var errs = listtest.Errors{
+	NotFound:        doublylinkedlist.ErrNotFound,
+	IndexOutOfRange: doublylinkedlist.ErrIndexOutOfRange,
+	Empty:           doublylinkedlist.ErrEmpty,
+	NoComparator:    doublylinkedlist.ErrNoComparator,
+}
+
+// unordered returns the zero value list, which has no Compare function and sets up its sentinels on first use
+func unordered() *doublylinkedlist.DoublyLinkedList[int] {
+	return &doublylinkedlist.DoublyLinkedList[int]{}
+}
+
+/*
+TestList runs the suite of the singly linked list on the doubly linked list
+Every program must pass on both, so both lists keep the same behavior for the shared methods
+*/
+func TestList(t *testing.T) {
+	t.Run("DoublyLinkedList", func(t *testing.T) { listtest.Run(t, doublylinkedlist.New[int], errs) })
+	t.Run("Unordered", func(t *testing.T) { listtest.Run(t, unordered, errs) })
+}
+
+func FuzzList(f *testing.F) {
+	listtest.Fuzz(f, doublylinkedlist.New[int], errs)
+}
@@ -0,0 +1,194 @@
+package doublylinkedlist_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+
+	"double/doublylinkedlist"
+)
+
+// lengths are the list lengths the round trips run at, 5000 values span more than one chunk of WriteTo
+var lengths = []int{0, 1, 3, 5000}
+
+// filled returns a list of ordered values 0, -1, 2, -3, ... so the sign of every other value is checked too
+func filled(n int) *doublylinkedlist.DoublyLinkedList[int] {
+	list := doublylinkedlist.New[int]()
+	for i := range n {
+		if i%2 == 1 {
+			i = -i
+		}
+		list.PushBack(i)
+	}
+	return list
+}
+
+// checkDecoded fails t if got does not hold the values of want in both directions, or lost its Compare function
+func checkDecoded(t *testing.T, name string, got, want *doublylinkedlist.DoublyLinkedList[int]) {
+	t.Helper()
+
+	values := slices.Collect(want.Values())
+	if forward := slices.Collect(got.Values()); !slices.Equal(forward, values) {
+		t.Fatalf("%s: got %d values %v, want %d", name, len(forward), forward, len(values))
+	}
+	slices.Reverse(values)
+	if backward := slices.Collect(got.Backward()); !slices.Equal(backward, values) || got.Len() != want.Len() {
+		t.Fatalf("%s: Backward got %d values, Len %d, want %d", name, len(backward), got.Len(), len(values))
+	}
+	if got.Compare == nil {
+		t.Fatalf("%s: the decoded list lost its Compare function", name)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	for _, n := range lengths {
+		list := filled(n)
+		data, err := json.Marshal(list)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		decoded := doublylinkedlist.New[int]()
+		decoded.PushBack(99)
+		if err := json.Unmarshal(data, decoded); err != nil {
+			t.Fatalf("n = %d: %v", n, err)
+		}
+		checkDecoded(t, "JSON", decoded, list)
+	}
+
+	if data, err := json.Marshal(doublylinkedlist.New[int]()); err != nil || string(data) != "[]" {
+		t.Fatalf("empty list: got %s, %v, want []", data, err)
+	}
+
+	decoded := filled(3)
+	if err := json.Unmarshal([]byte("null"), decoded); err != nil || decoded.Len() != 0 || decoded.FrontNode() != nil {
+		t.Fatalf("null: got %d values, %v, want an empty list", decoded.Len(), err)
+	}
+}
+
+func TestJSONInvalid(t *testing.T) {
+	for _, data := range []string{`{"a": 1}`, `[1, "two"]`, `[1, 2`, `7`, ``} {
+		list := filled(3)
+		if err := list.UnmarshalJSON([]byte(data)); err == nil {
+			t.Fatalf("%q: decoded without an error", data)
+		}
+		checkDecoded(t, data, list, filled(3))
+	}
+
+}
+
+// TestDecodeInvalidatesNodes checks that handles to the nodes of a list stop working once the list is decoded into
+func TestDecodeInvalidatesNodes(t *testing.T) {
+	list := filled(3)
+	old := list.FrontNode()
+	if err := json.Unmarshal([]byte("[7, 8]"), list); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := list.Remove(old); !errors.Is(err, doublylinkedlist.ErrInvalidNode) {
+		t.Fatalf("Remove of a node from before the decode: got %v, want %v", err, doublylinkedlist.ErrInvalidNode)
+	}
+	if got := slices.Collect(list.Values()); !slices.Equal(got, []int{7, 8}) {
+		t.Fatalf("got %v, want [7 8]", got)
+	}
+	if node := list.FrontNode(); node == nil || node.Next() != list.BackNode() || list.BackNode().Next() != nil {
+		t.Fatal("the decoded nodes are not linked to the list")
+	}
+}
+
+func TestGob(t *testing.T) {
+	for _, n := range lengths {
+		list := filled(n)
+		var network bytes.Buffer
+		if err := gob.NewEncoder(&network).Encode(list); err != nil {
+			t.Fatal(err)
+		}
+
+		decoded := doublylinkedlist.New[int]()
+		if err := gob.NewDecoder(&network).Decode(decoded); err != nil {
+			t.Fatalf("n = %d: %v", n, err)
+		}
+		checkDecoded(t, "gob", decoded, list)
+	}
+
+	list := filled(3)
+	if err := list.UnmarshalBinary([]byte{1, 2, 3}); err == nil {
+		t.Fatal("gob: decoded garbage without an error")
+	}
+	checkDecoded(t, "gob garbage", list, filled(3))
+}
+
+func TestWriteToReadFrom(t *testing.T) {
+	for _, n := range lengths {
+		list := filled(n)
+		var file bytes.Buffer
+		written, err := list.WriteTo(&file)
+		if err != nil || written != int64(9+8*n) || int64(file.Len()) != written {
+			t.Fatalf("n = %d: WriteTo wrote %d bytes into %d, %v, want %d", n, written, file.Len(), err, 9+8*n)
+		}
+
+		// A second list after the first checks that ReadFrom stops at the end of its own bytes
+		filled(2).WriteTo(&file)
+		decoded := doublylinkedlist.New[int]()
+		read, err := decoded.ReadFrom(&file)
+		if err != nil || read != written {
+			t.Fatalf("n = %d: ReadFrom read %d bytes, %v, want %d", n, read, err, written)
+		}
+		checkDecoded(t, "ReadFrom", decoded, list)
+		if file.Len() != 9+2*8 {
+			t.Fatalf("n = %d: ReadFrom left %d bytes, want the %d of the next list", n, file.Len(), 9+2*8)
+		}
+	}
+
+	floats := doublylinkedlist.New[float32]()
+	floats.PushBack(1.5)
+	floats.PushBack(-2)
+	var file bytes.Buffer
+	floats.WriteTo(&file)
+	decoded := doublylinkedlist.New[float32]()
+	if _, err := decoded.ReadFrom(&file); err != nil || !slices.Equal(slices.Collect(decoded.Values()), []float32{1.5, -2}) {
+		t.Fatalf("float32: got %v, %v", slices.Collect(decoded.Values()), err)
+	}
+
+	if _, err := doublylinkedlist.New[string]().WriteTo(&file); !errors.Is(err, doublylinkedlist.ErrUnsupportedType) {
+		t.Fatalf("WriteTo of strings: got %v, want %v", err, doublylinkedlist.ErrUnsupportedType)
+	}
+	if _, err := doublylinkedlist.New[string]().ReadFrom(&file); !errors.Is(err, doublylinkedlist.ErrUnsupportedType) {
+		t.Fatalf("ReadFrom of strings: got %v, want %v", err, doublylinkedlist.ErrUnsupportedType)
+	}
+}
+
+// header returns the 9 byte header of WriteTo for count values of size bytes
+func header(count uint64, size byte) []byte {
+	return append(binary.LittleEndian.AppendUint64(nil, count), size)
+}
+
+func TestReadFromInvalid(t *testing.T) {
+	var valid bytes.Buffer
+	filled(3).WriteTo(&valid)
+
+	inputs := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"Empty", nil, io.EOF},
+		{"ShortHeader", header(3, 8)[:5], io.ErrUnexpectedEOF},
+		{"WrongValueSize", append(header(3, 4), make([]byte, 12)...), doublylinkedlist.ErrInvalidEncoding},
+		{"TruncatedChunk", valid.Bytes()[:valid.Len()-3], io.ErrUnexpectedEOF},
+		{"OversizedLength", append(header(1<<62, 8), make([]byte, 64)...), io.ErrUnexpectedEOF},
+	}
+
+	for _, input := range inputs {
+		list := filled(2)
+		if _, err := list.ReadFrom(bytes.NewReader(input.data)); !errors.Is(err, input.want) {
+			t.Fatalf("%s: got %v, want %v", input.name, err, input.want)
+		}
+		checkDecoded(t, input.name, list, filled(2))
+	}
+}
@@ -0,0 +1,17 @@
+package doublylinkedlist
+
+import "errors"
+
+/*
+Sentinel errors returned by the DoublyLinkedList operations
+They mirror the errors of the singly linked list, plus ErrInvalidNode for node handles that do not belong to the list
+*/
+var (
+	ErrNotFound        = errors.New("doublylinkedlist: no record found")
+	ErrIndexOutOfRange = errors.New("doublylinkedlist: index out of range")
+	ErrEmpty           = errors.New("doublylinkedlist: list is empty")
+	ErrNoComparator    = errors.New("doublylinkedlist: list has no compare function")
+	ErrInvalidNode     = errors.New("doublylinkedlist: node does not belong to the list")
+	ErrUnsupportedType = errors.New("doublylinkedlist: type has no fixed size binary encoding")
+	ErrInvalidEncoding = errors.New("doublylinkedlist: invalid encoding")
+)
@@ -0,0 +1,68 @@
+package doublylinkedlist
+
+import (
+	"cmp"
+	"iter"
+)
+
+/*
+All returns an iterator over the positions and values of the list, from front to back
+It can be used as: for i, v := range list.All()
+*/
+func (list *DoublyLinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		list.lazyInit()
+		index := 0
+		for current := list.head.next; current != list.tail; current = current.next {
+			if !yield(index, current.Data) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Values returns an iterator over the values of the list, from front to back
+func (list *DoublyLinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		list.lazyInit()
+		for current := list.head.next; current != list.tail; current = current.next {
+			if !yield(current.Data) {
+				return
+			}
+		}
+	}
+}
+
+/*
+Backward returns an iterator over the values of the list, from back to front
+It simply follows the Prev links, so unlike the singly linked list it needs no extra memory
+*/
+func (list *DoublyLinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		list.lazyInit()
+		for current := list.tail.prev; current != list.head; current = current.prev {
+			if !yield(current.Data) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq builds a list without a Compare function from the values of seq, in order
+func FromSeq[T comparable](seq iter.Seq[T]) *DoublyLinkedList[T] {
+	list := &DoublyLinkedList[T]{}
+	for v := range seq {
+		list.PushBack(v)
+	}
+	return list
+}
+
+// Collect builds a list of an ordered type from the values of seq, in order, like New it uses cmp.Compare
+func Collect[T cmp.Ordered](seq iter.Seq[T]) *DoublyLinkedList[T] {
+	list := New[T]()
+	for v := range seq {
+		list.PushBack(v)
+	}
+	return list
+}
@@ -0,0 +1,157 @@
+package doublylinkedlist_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"double/doublylinkedlist"
+)
+
+// checkNodes walks the list with Next from FrontNode and with Prev from BackNode and fails t unless both walks meet want
+func checkNodes(t *testing.T, list *doublylinkedlist.DoublyLinkedList[int], want []*doublylinkedlist.Node[int]) {
+	t.Helper()
+
+	var forward []*doublylinkedlist.Node[int]
+	for node := list.FrontNode(); node != nil && len(forward) <= len(want); node = node.Next() {
+		forward = append(forward, node)
+	}
+	var backward []*doublylinkedlist.Node[int]
+	for node := list.BackNode(); node != nil && len(backward) <= len(want); node = node.Prev() {
+		backward = append(backward, node)
+	}
+	slices.Reverse(backward)
+
+	if !slices.Equal(forward, want) || !slices.Equal(backward, want) || list.Len() != len(want) {
+		t.Fatalf("got %d nodes forward and %d backward with Len %d, want %d", len(forward), len(backward), list.Len(), len(want))
+	}
+}
+
+/*
+TestNodes runs random node handle operations against a slice of the handles the list must hold, in order
+Every handle stays valid until its node is removed, and every removed handle must be rejected with ErrInvalidNode
+*/
+func TestNodes(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+	list := doublylinkedlist.New[int]()
+	var want, removed []*doublylinkedlist.Node[int]
+
+	for step := range 3000 {
+		if len(want) == 0 {
+			want = append(want, list.PushBack(step))
+		}
+		i := random.IntN(len(want))
+		mark := want[i]
+
+		switch random.IntN(8) {
+		case 0:
+			want = slices.Insert(want, 0, list.PushFront(step))
+		case 1:
+			want = append(want, list.PushBack(step))
+		case 2:
+			node, err := list.InsertBefore(step, mark)
+			if err != nil || node.Data != step {
+				t.Fatalf("step %d: InsertBefore returned %v, %v", step, node, err)
+			}
+			want = slices.Insert(want, i, node)
+		case 3:
+			node, err := list.InsertAfter(step, mark)
+			if err != nil || node.Data != step {
+				t.Fatalf("step %d: InsertAfter returned %v, %v", step, node, err)
+			}
+			want = slices.Insert(want, i+1, node)
+		case 4:
+			if data, err := list.Remove(mark); err != nil || data != mark.Data {
+				t.Fatalf("step %d: Remove returned %d, %v, want %d", step, data, err, mark.Data)
+			}
+			want = slices.Delete(want, i, i+1)
+			removed = append(removed, mark)
+		case 5:
+			if err := list.MoveToFront(mark); err != nil {
+				t.Fatalf("step %d: MoveToFront: %v", step, err)
+			}
+			want = slices.Insert(slices.Delete(want, i, i+1), 0, mark)
+		case 6:
+			if err := list.MoveToBack(mark); err != nil {
+				t.Fatalf("step %d: MoveToBack: %v", step, err)
+			}
+			want = append(slices.Delete(want, i, i+1), mark)
+		case 7:
+			front, back := want[0], want[len(want)-1]
+			if random.IntN(2) == 0 {
+				if data, err := list.PopFront(); err != nil || data != front.Data {
+					t.Fatalf("step %d: PopFront returned %d, %v, want %d", step, data, err, front.Data)
+				}
+				want, removed = want[1:], append(removed, front)
+			} else {
+				if data, err := list.PopBack(); err != nil || data != back.Data {
+					t.Fatalf("step %d: PopBack returned %d, %v, want %d", step, data, err, back.Data)
+				}
+				want, removed = want[:len(want)-1], append(removed, back)
+			}
+		}
+
+		checkNodes(t, list, want)
+		if len(removed) > 0 {
+			stale := removed[random.IntN(len(removed))]
+			if _, err := list.Remove(stale); !errors.Is(err, doublylinkedlist.ErrInvalidNode) {
+				t.Fatalf("step %d: Remove of a removed node: got %v, want %v", step, err, doublylinkedlist.ErrInvalidNode)
+			}
+		}
+	}
+}
+
+// TestInvalidNode checks that every node handle method rejects nodes of another list, removed nodes and nil
+func TestInvalidNode(t *testing.T) {
+	list, other := doublylinkedlist.New[int](), doublylinkedlist.New[int]()
+	list.PushBack(1)
+	foreign := other.PushBack(2)
+	removed := list.PushBack(3)
+	list.Remove(removed)
+
+	for name, node := range map[string]*doublylinkedlist.Node[int]{"Foreign": foreign, "Removed": removed, "Nil": nil} {
+		if _, err := list.InsertBefore(0, node); !errors.Is(err, doublylinkedlist.ErrInvalidNode) {
+			t.Fatalf("%s: InsertBefore returned %v", name, err)
+		}
+		if _, err := list.InsertAfter(0, node); !errors.Is(err, doublylinkedlist.ErrInvalidNode) {
+			t.Fatalf("%s: InsertAfter returned %v", name, err)
+		}
+		if _, err := list.Remove(node); !errors.Is(err, doublylinkedlist.ErrInvalidNode) {
+			t.Fatalf("%s: Remove returned %v", name, err)
+		}
+		if err := list.MoveToFront(node); !errors.Is(err, doublylinkedlist.ErrInvalidNode) {
+			t.Fatalf("%s: MoveToFront returned %v", name, err)
+		}
+		if err := list.MoveToBack(node); !errors.Is(err, doublylinkedlist.ErrInvalidNode) {
+			t.Fatalf("%s: MoveToBack returned %v", name, err)
+		}
+	}
+
+	if list.Len() != 1 || other.Len() != 1 || list.FrontNode() != list.BackNode() || other.FrontNode() != foreign {
+		t.Fatalf("rejected calls changed the lists: Len %d and %d", list.Len(), other.Len())
+	}
+	if removed.Next() != nil || removed.Prev() != nil {
+		t.Fatal("a removed node still links into the list")
+	}
+}
+
+// TestEmptyNodes checks the node accessors and the pops of an empty list, also of the zero value
+func TestEmptyNodes(t *testing.T) {
+	for name, list := range map[string]*doublylinkedlist.DoublyLinkedList[int]{"New": doublylinkedlist.New[int](), "Zero": {}} {
+		if list.FrontNode() != nil || list.BackNode() != nil {
+			t.Fatalf("%s: an empty list returned a node", name)
+		}
+		if _, err := list.PopFront(); !errors.Is(err, doublylinkedlist.ErrEmpty) {
+			t.Fatalf("%s: PopFront returned %v", name, err)
+		}
+		if _, err := list.PopBack(); !errors.Is(err, doublylinkedlist.ErrEmpty) {
+			t.Fatalf("%s: PopBack returned %v", name, err)
+		}
+
+		node := list.PushBack(1)
+		if list.FrontNode() != node || list.BackNode() != node || node.Next() != nil || node.Prev() != nil {
+			t.Fatalf("%s: the only node is not both ends", name)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package doublylinkedlist
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"generic/diagram"
+)
+
+/*
+walk returns the real nodes reachable from the head sentinel along the next links, each one once
+It stops at the tail sentinel, at a nil link or at a node it has already seen, so it always terminates
+If the last node links back to an earlier node, loop is the index of that node, otherwise loop is -1
+*/
+func (list *DoublyLinkedList[T]) walk() (nodes []*Node[T], loop int) {
+	list.lazyInit()
+
+	index := make(map[*Node[T]]int)
+	for current := list.head.next; current != nil && current != list.tail; current = current.next {
+		if i, seen := index[current]; seen {
+			return nodes, i
+		}
+		index[current] = len(nodes)
+		nodes = append(nodes, current)
+	}
+	return nodes, -1
+}
+
+/*
+linked reports whether the prev link of nodes[i] points back to the node before it (the head sentinel for the first node)
+An index equal to len(nodes) checks the prev link of the tail sentinel
+*/
+func (list *DoublyLinkedList[T]) linked(nodes []*Node[T], i int) bool {
+	before := list.head
+	if i > 0 {
+		before = nodes[i-1]
+	}
+
+	if i == len(nodes) {
+		return list.tail.prev == before
+	}
+	return nodes[i].prev == before
+}
+
+/*
+problems lists the links, owners and sizes that do not agree with the nodes reachable from the head sentinel
+A cycle is reported with the node it starts at, the prev link of the tail sentinel is only checked when the next links reach it
+*/
+func (list *DoublyLinkedList[T]) problems(nodes []*Node[T], loop int) []string {
+	var problems []string
+	last := list.head
+	if len(nodes) > 0 {
+		last = nodes[len(nodes)-1]
+	}
+	switch {
+	case loop >= 0:
+		problems = append(problems, fmt.Sprintf("cycle: the last node links back to node %d", loop))
+	case last.next != list.tail:
+		problems = append(problems, "the next links do not reach the tail sentinel")
+	}
+
+	for i := 0; i <= len(nodes); i++ {
+		if i == len(nodes) && last.next != list.tail {
+			break
+		}
+		if !list.linked(nodes, i) {
+			problems = append(problems, fmt.Sprintf("the prev link of node %d does not point to node %d", i, i-1))
+		}
+		if i < len(nodes) && nodes[i].list != list {
+			problems = append(problems, fmt.Sprintf("node %d does not belong to the list", i))
+		}
+	}
+
+	if list.size != len(nodes) {
+		problems = append(problems, fmt.Sprintf("size is %d but %d nodes are reachable", list.size, len(nodes)))
+	}
+	return problems
+}
+
+/*
+RenderASCII draws the list as text between its two sentinels:
+
+	head <-> [1] <-> [2] <-> [3] <-> tail
+
+A link whose prev pointer does not point back is drawn as a one way " --> ", a cycle as an arrow from the last node
+back to the node it links to, and any broken link, cycle, foreign node or wrong size is listed below the drawing
+*/
+func (list *DoublyLinkedList[T]) RenderASCII() string {
+	nodes, loop := list.walk()
+
+	cells := []string{"head"}
+	arrows := []string{""}
+	for i, node := range nodes {
+		cells = append(cells, fmt.Sprintf("[%v]", node.Data))
+		arrows = append(arrows, list.arrow(nodes, i))
+	}
+
+	end := " ... "
+	if len(nodes) == 0 || nodes[len(nodes)-1].next == list.tail {
+		end = list.arrow(nodes, len(nodes))
+	}
+
+	// The head sentinel is cell 0, so node i is cell i+1
+	back := -1
+	if loop >= 0 {
+		back = loop + 1
+	}
+	drawing := diagram.Chain(cells, arrows, nil, end+"tail", "", back)
+	return drawing + diagram.Problems(list.problems(nodes, loop))
+}
+
+// arrow returns the arrow drawn in front of nodes[i], or in front of the tail sentinel for len(nodes)
+func (list *DoublyLinkedList[T]) arrow(nodes []*Node[T], i int) string {
+	if list.linked(nodes, i) {
+		return " <-> "
+	}
+	return " --> "
+}
+
+/*
+ToDOT describes the list as a Graphviz digraph, render it with: dot -Tsvg list.dot -o list.svg
+The sentinels are drawn as points, next links as solid edges and prev links as dashed edges,
+a prev link that does not point to the node before it and the next link that closes a cycle are drawn in red
+*/
+func (list *DoublyLinkedList[T]) ToDOT() string {
+	nodes, loop := list.walk()
+
+	name := func(node *Node[T]) string {
+		switch node {
+		case list.head:
+			return "head"
+		case list.tail:
+			return "tail"
+		}
+		if i := slices.Index(nodes, node); i >= 0 {
+			return fmt.Sprintf("n%d", i)
+		}
+		return "unknown"
+	}
+
+	var dot strings.Builder
+	dot.WriteString("digraph DoublyLinkedList {\n\trankdir=LR;\n\tnode [shape=box];\n")
+	dot.WriteString("\thead [shape=point];\n\ttail [shape=point];\n")
+	for i, node := range nodes {
+		fmt.Fprintf(&dot, "\tn%d [label=%q];\n", i, fmt.Sprint(node.Data))
+	}
+
+	all := append(append([]*Node[T]{list.head}, nodes...), list.tail)
+	for i := 1; i < len(all); i++ {
+		if all[i-1].next == all[i] {
+			fmt.Fprintf(&dot, "\t%s -> %s;\n", name(all[i-1]), name(all[i]))
+		}
+
+		style := "style=dashed"
+		if !list.linked(nodes, i-1) {
+			style += ", color=red"
+		}
+		if prev := all[i].prev; prev != nil {
+			fmt.Fprintf(&dot, "\t%s -> %s [%s];\n", name(all[i]), name(prev), style)
+		}
+	}
+
+	if loop >= 0 {
+		fmt.Fprintf(&dot, "\tn%d -> n%d [color=red, label=\"cycle\"];\n", len(nodes)-1, loop)
+	}
+
+	for _, problem := range list.problems(nodes, loop) {
+		fmt.Fprintf(&dot, "\t// %s\n", problem)
+	}
+	dot.WriteString("}\n")
+	return dot.String()
+}
@@ -0,0 +1,30 @@
+package doublylinkedlist
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestRenderCycle links the last node back to the second one and checks that both drawings report where the cycle starts
+func TestRenderCycle(t *testing.T) {
+	list := New[int]()
+	list.PushBack(1)
+	second := list.PushBack(2)
+	last := list.PushBack(3)
+	last.next = second
+
+	ascii := list.RenderASCII()
+	for _, want := range []string{"head <-> [1] <-> [2] <-> [3] -+", "^", "! cycle: the last node links back to node 1"} {
+		if !strings.Contains(ascii, want) {
+			t.Errorf("RenderASCII is missing %q:\n%s", want, ascii)
+		}
+	}
+	if strings.Contains(ascii, "tail sentinel") {
+		t.Errorf("RenderASCII reports the cycle as a broken chain:\n%s", ascii)
+	}
+
+	dot := list.ToDOT()
+	if !strings.Contains(dot, `n2 -> n1 [color=red, label="cycle"]`) {
+		t.Errorf("ToDOT has no cycle edge:\n%s", dot)
+	}
+}
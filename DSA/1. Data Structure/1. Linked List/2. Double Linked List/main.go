@@ -1,5 +1,80 @@
 package main
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"double/doublylinkedlist"
+)
+
 func main() {
+	list := doublylinkedlist.New[int]()
+
+	list.InsertAtBack(6)
+	list.InsertAtBack(6)
+	list.InsertAtFront(2)
+	list.InsertAtFront(1)
+	list.InsertAfterValue(6, 3)
+	list.InsertBeforeValue(3, 4)
+	list.InsertInSortedList(5)
+	if err := list.InsertAtSpecficPosition(11, 9); err != nil {
+		fmt.Println("InsertAtSpecficPosition :", err)
+	}
+
+	list.UpdateByPosition(13, 5)
+	if deleted, err := list.DeleteAllByValue(6); err == nil {
+		fmt.Println("Deleted :", deleted)
+	}
+	list.Print()
+
+	if mid, err := list.FindMiddle(); err == nil {
+		fmt.Printf("Mid Value : %v \n", mid)
+	}
+
+	list.Reverse()
+	fmt.Println("Reversed :", slices.Collect(list.Values()))
+	fmt.Println("Backward :", slices.Collect(list.Backward()))
+
+	// Deque usage with node handles
+	deque := doublylinkedlist.DoublyLinkedList[string]{}
+	first := deque.PushBack("first")
+	deque.PushBack("second")
+	last := deque.PushBack("last")
+	deque.InsertAfter("middle", first)
+	deque.MoveToFront(last)
+	deque.Remove(first)
+	for i, v := range deque.All() {
+		fmt.Printf("Deque %d : %v \n", i, v)
+	}
+	if front, err := deque.PopFront(); err == nil {
+		fmt.Println("PopFront :", front)
+	}
+	if back, err := deque.PopBack(); err == nil {
+		fmt.Println("PopBack :", back)
+	}
+	if _, err := deque.Remove(first); err != nil {
+		fmt.Println("Remove :", err)
+	}
+
+	// Encoding round trips
+	encoded, _ := json.Marshal(list)
+	fmt.Println("JSON :", string(encoded))
+	var decoded doublylinkedlist.DoublyLinkedList[int]
+	if err := json.Unmarshal(encoded, &decoded); err == nil {
+		fmt.Println("Decoded Backward :", slices.Collect(decoded.Backward()))
+	}
+
+	var stream bytes.Buffer
+	written, _ := list.WriteTo(&stream)
+	var loaded doublylinkedlist.DoublyLinkedList[int]
+	if read, err := loaded.ReadFrom(&stream); err == nil {
+		fmt.Println("Binary :", written, "bytes written,", read, "bytes read,", loaded.Len(), "values")
+	}
 
+	// Drawings for debugging the links
+	fmt.Print(deque.RenderASCII())
+	fmt.Print(loaded.RenderASCII())
+	fmt.Print(loaded.ToDOT())
 }
@@ -0,0 +1,13 @@
+module lrucache
+
+go 1.23.4
+
+require (
+	double v0.0.0
+	generic v0.0.0
+)
+
+replace (
+	double => "../2. Double Linked List"
+	generic => "../1. Single Linked List/1. With Generic"
+)
@@ -0,0 +1,251 @@
+package lru
+
+import (
+	"sync"
+	"time"
+
+	"double/doublylinkedlist"
+)
+
+// entry is a single key/value pair stored in the recency list
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	size      int64
+	expiresAt time.Time
+}
+
+/*
+Cache is a least recently used cache
+The entries live in a doubly linked list ordered from most to least recently used,
+and a map points from each key to its node, so Get, Put and Delete are all O(1)
+On Get the node is moved to the front, on eviction the node at the back is removed
+The zero value is an empty cache without limits, ready to use
+*/
+type Cache[K comparable, V any] struct {
+	mu      sync.Mutex
+	config  Config[K, V]
+	list    doublylinkedlist.DoublyLinkedList[*entry[K, V]]
+	items   map[K]*doublylinkedlist.Node[*entry[K, V]]
+	bytes   int64
+	stats   Stats
+	nowFunc func() time.Time
+}
+
+// New returns an empty LRU cache using the given config
+func New[K comparable, V any](config Config[K, V]) *Cache[K, V] {
+	return &Cache[K, V]{
+		config:  config,
+		items:   make(map[K]*doublylinkedlist.Node[*entry[K, V]]),
+		nowFunc: time.Now,
+	}
+}
+
+/*
+Get returns the value stored for key and marks it as the most recently used
+An expired entry is removed and reported as a miss
+*/
+func (cache *Cache[K, V]) Get(key K) (V, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	node, ok := cache.lookup(key)
+	if !ok {
+		cache.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	cache.stats.Hits++
+	cache.list.MoveToFront(node)
+	return node.Data.value, true
+}
+
+// Peek returns the value stored for key without changing its recency or the statistics
+func (cache *Cache[K, V]) Peek(key K) (V, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	node, ok := cache.lookup(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return node.Data.value, true
+}
+
+// Put stores value for key with the default TTL and marks it as the most recently used
+func (cache *Cache[K, V]) Put(key K, value V) {
+	cache.PutWithTTL(key, value, cache.config.TTL)
+}
+
+/*
+PutWithTTL stores value for key with its own time to live, 0 means the entry never expires
+If the key already exists its value is replaced, otherwise a new entry is added at the front
+Least recently used entries are then evicted until MaxEntries and MaxBytes are respected
+*/
+func (cache *Cache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.items == nil {
+		cache.items = make(map[K]*doublylinkedlist.Node[*entry[K, V]])
+	}
+
+	size := cache.config.size(key, value)
+	expiresAt := expiry(cache.now(), ttl)
+
+	if node, ok := cache.items[key]; ok {
+		cache.bytes += size - node.Data.size
+		node.Data.value = value
+		node.Data.size = size
+		node.Data.expiresAt = expiresAt
+		cache.list.MoveToFront(node)
+	} else {
+		cache.items[key] = cache.list.PushFront(&entry[K, V]{key: key, value: value, size: size, expiresAt: expiresAt})
+		cache.bytes += size
+	}
+
+	cache.evictOverflow()
+}
+
+// Delete removes key from the cache and reports whether it was present
+func (cache *Cache[K, V]) Delete(key K) bool {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	node, ok := cache.items[key]
+	if !ok {
+		return false
+	}
+
+	cache.remove(node, ReasonDeleted)
+	return true
+}
+
+// Len returns the number of entries, including expired entries that were not removed yet
+func (cache *Cache[K, V]) Len() int {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	return cache.list.Len()
+}
+
+// Bytes returns the total estimated size of the entries
+func (cache *Cache[K, V]) Bytes() int64 {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	return cache.bytes
+}
+
+// Keys returns the keys from the most to the least recently used
+func (cache *Cache[K, V]) Keys() []K {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	keys := make([]K, 0, cache.list.Len())
+	for e := range cache.list.Values() {
+		keys = append(keys, e.key)
+	}
+	return keys
+}
+
+// Stats returns a snapshot of the hit and miss counters
+func (cache *Cache[K, V]) Stats() Stats {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	return cache.stats
+}
+
+/*
+PurgeExpired removes every expired entry and returns how many were removed
+Expired entries are otherwise only removed lazily when they are read
+*/
+func (cache *Cache[K, V]) PurgeExpired() int {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	now := cache.now()
+	removed := 0
+	for node := cache.list.FrontNode(); node != nil; {
+		next := node.Next()
+		if expired(node.Data.expiresAt, now) {
+			cache.remove(node, ReasonExpired)
+			removed++
+		}
+		node = next
+	}
+	return removed
+}
+
+// Purge removes every entry from the cache, calling OnEvict for each of them
+func (cache *Cache[K, V]) Purge() {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	for node := cache.list.BackNode(); node != nil; node = cache.list.BackNode() {
+		cache.remove(node, ReasonDeleted)
+	}
+}
+
+// now returns the current time from nowFunc, which tests replace with a fake clock, or from time.Now for the zero value
+func (cache *Cache[K, V]) now() time.Time {
+	if cache.nowFunc == nil {
+		return time.Now()
+	}
+	return cache.nowFunc()
+}
+
+// lookup returns the node of a live entry, removing it first if it has expired
+func (cache *Cache[K, V]) lookup(key K) (*doublylinkedlist.Node[*entry[K, V]], bool) {
+	node, ok := cache.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	if expired(node.Data.expiresAt, cache.now()) {
+		cache.remove(node, ReasonExpired)
+		return nil, false
+	}
+	return node, true
+}
+
+// evictOverflow removes least recently used entries until the cache fits its limits
+func (cache *Cache[K, V]) evictOverflow() {
+	for cache.overflow() {
+		cache.remove(cache.list.BackNode(), ReasonCapacity)
+	}
+}
+
+// overflow reports whether the cache holds more than MaxEntries or MaxBytes
+func (cache *Cache[K, V]) overflow() bool {
+	if cache.list.Len() == 0 {
+		return false
+	}
+
+	if cache.config.MaxEntries > 0 && cache.list.Len() > cache.config.MaxEntries {
+		return true
+	}
+	return cache.config.MaxBytes > 0 && cache.bytes > cache.config.MaxBytes
+}
+
+// remove unlinks node, updates the counters and calls OnEvict
+func (cache *Cache[K, V]) remove(node *doublylinkedlist.Node[*entry[K, V]], reason EvictReason) {
+	e := node.Data
+	cache.list.Remove(node)
+	delete(cache.items, e.key)
+	cache.bytes -= e.size
+
+	switch reason {
+	case ReasonCapacity:
+		cache.stats.Evictions++
+	case ReasonExpired:
+		cache.stats.Expirations++
+	}
+
+	if cache.config.OnEvict != nil {
+		cache.config.OnEvict(e.key, e.value, reason)
+	}
+}
@@ -0,0 +1,380 @@
+package lru
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// clock is a fake time source for nowFunc, tests move it forward by hand instead of sleeping
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func (c *clock) advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+// evictions records the OnEvict calls of a cache as "key=value (reason)"
+type evictions []string
+
+func (e *evictions) record(key string, value int, reason EvictReason) {
+	*e = append(*e, fmt.Sprintf("%s=%d (%v)", key, value, reason))
+}
+
+func TestCacheEviction(t *testing.T) {
+	var evicted evictions
+	cache := New(Config[string, int]{MaxEntries: 3, OnEvict: evicted.record})
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Get("a")
+	cache.Peek("b")
+	cache.Put("d", 4)
+
+	if keys := cache.Keys(); !slices.Equal(keys, []string{"d", "a", "c"}) {
+		t.Fatalf("Keys: got %v, want [d a c]", keys)
+	}
+	if !slices.Equal(evicted, evictions{"b=2 (capacity)"}) {
+		t.Fatalf("OnEvict: got %v, want [b=2 (capacity)]", evicted)
+	}
+
+	cache.Put("c", 30)
+	cache.Put("e", 5)
+	if keys := cache.Keys(); !slices.Equal(keys, []string{"e", "c", "d"}) {
+		t.Fatalf("Keys after updating c: got %v, want [e c d]", keys)
+	}
+	if v, ok := cache.Get("c"); !ok || v != 30 {
+		t.Fatalf("Get(c): got %d, %t, want 30", v, ok)
+	}
+
+	if !cache.Delete("d") || cache.Delete("d") || cache.Len() != 2 {
+		t.Fatalf("Delete: Len %d after deleting d", cache.Len())
+	}
+	want := Stats{Hits: 2, Misses: 0, Evictions: 2}
+	if _, ok := cache.Get("b"); ok {
+		t.Fatal("Get(b): an evicted key was found")
+	}
+	want.Misses++
+	if stats := cache.Stats(); stats != want {
+		t.Fatalf("Stats: got %+v, want %+v", stats, want)
+	}
+
+	cache.Purge()
+	if cache.Len() != 0 || evicted[len(evicted)-1] != "c=30 (deleted)" {
+		t.Fatalf("Purge: Len %d, evictions %v", cache.Len(), evicted)
+	}
+}
+
+func TestCacheMaxBytes(t *testing.T) {
+	cache := New(Config[string, int]{
+		MaxBytes: 10,
+		Sizer:    func(key string, value int) int64 { return int64(value) },
+	})
+	cache.Put("a", 4)
+	cache.Put("b", 4)
+	cache.Put("c", 4)
+	if keys := cache.Keys(); !slices.Equal(keys, []string{"c", "b"}) || cache.Bytes() != 8 {
+		t.Fatalf("got %v with %d bytes, want [c b] with 8", keys, cache.Bytes())
+	}
+
+	cache.Put("b", 1)
+	if cache.Bytes() != 5 {
+		t.Fatalf("Bytes after shrinking b: got %d, want 5", cache.Bytes())
+	}
+
+	// An entry larger than the whole cache does not fit even on its own
+	cache.Put("huge", 11)
+	if cache.Len() != 0 || cache.Bytes() != 0 {
+		t.Fatalf("after a too large entry: Len %d, Bytes %d, want an empty cache", cache.Len(), cache.Bytes())
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	var evicted evictions
+	c := &clock{now: time.Unix(1000, 0)}
+	cache := New(Config[string, int]{TTL: time.Second, OnEvict: evicted.record})
+	cache.nowFunc = c.Now
+
+	cache.Put("short", 1)
+	cache.PutWithTTL("long", 2, time.Minute)
+	cache.PutWithTTL("forever", 3, 0)
+
+	c.advance(time.Second - 1)
+	if _, ok := cache.Get("short"); !ok {
+		t.Fatal("short expired before its TTL")
+	}
+	c.advance(1)
+	if _, ok := cache.Peek("short"); ok {
+		t.Fatal("short did not expire at its TTL")
+	}
+	if cache.Len() != 2 || !slices.Equal(evicted, evictions{"short=1 (expired)"}) {
+		t.Fatalf("after reading short: Len %d, evictions %v", cache.Len(), evicted)
+	}
+
+	// Updating an entry starts its TTL again
+	cache.PutWithTTL("long", 20, time.Minute)
+	c.advance(59 * time.Second)
+	if v, ok := cache.Get("long"); !ok || v != 20 {
+		t.Fatalf("long: got %d, %t, want 20 before its renewed TTL", v, ok)
+	}
+
+	c.advance(time.Hour)
+	if removed := cache.PurgeExpired(); removed != 1 || !slices.Equal(cache.Keys(), []string{"forever"}) {
+		t.Fatalf("PurgeExpired removed %d and left %v, want 1 and [forever]", removed, cache.Keys())
+	}
+	if stats := cache.Stats(); stats.Expirations != 2 {
+		t.Fatalf("Expirations: got %d, want 2", stats.Expirations)
+	}
+}
+
+/*
+TestCacheModel runs random operations against a slice of keys from the most to the least recently used
+After every step the order of the cache and its OnEvict calls must match the model
+*/
+func TestCacheModel(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+	const maxEntries = 5
+	var evicted []string
+	cache := New(Config[string, int]{
+		MaxEntries: maxEntries,
+		OnEvict:    func(key string, _ int, _ EvictReason) { evicted = append(evicted, key) },
+	})
+	var model, wantEvicted []string
+	touch := func(key string) {
+		model = slices.Insert(slices.DeleteFunc(model, func(k string) bool { return k == key }), 0, key)
+	}
+
+	for step := range 5000 {
+		key := fmt.Sprint(random.IntN(10))
+		switch random.IntN(3) {
+		case 0:
+			cache.Put(key, step)
+			touch(key)
+			if len(model) > maxEntries {
+				wantEvicted = append(wantEvicted, model[maxEntries])
+				model = model[:maxEntries]
+			}
+		case 1:
+			_, ok := cache.Get(key)
+			if ok != slices.Contains(model, key) {
+				t.Fatalf("step %d: Get(%s) found %t, model %v", step, key, ok, model)
+			}
+			if ok {
+				touch(key)
+			}
+		case 2:
+			if cache.Delete(key) != slices.Contains(model, key) {
+				t.Fatalf("step %d: Delete(%s) disagrees with model %v", step, key, model)
+			}
+			if slices.Contains(model, key) {
+				wantEvicted = append(wantEvicted, key)
+				model = slices.DeleteFunc(model, func(k string) bool { return k == key })
+			}
+		}
+
+		if keys := cache.Keys(); !slices.Equal(keys, model) || cache.Len() != len(model) {
+			t.Fatalf("step %d: got %v, want %v", step, keys, model)
+		}
+		if !slices.Equal(evicted, wantEvicted) {
+			t.Fatalf("step %d: evicted %v, want %v", step, evicted, wantEvicted)
+		}
+	}
+}
+
+func TestLFU(t *testing.T) {
+	var evicted evictions
+	cache := NewLFU(Config[string, int]{MaxEntries: 3, OnEvict: evicted.record})
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Get("a")
+	cache.Get("a")
+	cache.Get("c")
+	cache.Put("b", 20)
+
+	for key, want := range map[string]uint64{"a": 3, "b": 2, "c": 2, "missing": 0} {
+		if freq := cache.Frequency(key); freq != want {
+			t.Fatalf("Frequency(%s): got %d, want %d", key, freq, want)
+		}
+	}
+
+	// b and c are tied at 2, c was used before b so it is the least recently used of the lowest bucket
+	cache.Put("d", 4)
+	cache.Put("e", 5)
+	if !slices.Equal(evicted, evictions{"c=3 (capacity)", "d=4 (capacity)"}) {
+		t.Fatalf("evictions: got %v", evicted)
+	}
+	if _, ok := cache.Peek("e"); !ok || cache.Len() != 3 || cache.Frequency("e") != 1 {
+		t.Fatalf("the entry just written was evicted: Len %d", cache.Len())
+	}
+}
+
+// TestLFUKeepsNewEntry checks that a new entry alone in the lowest bucket is kept and the next bucket gives up its victim
+func TestLFUKeepsNewEntry(t *testing.T) {
+	var evicted evictions
+	cache := NewLFU(Config[string, int]{MaxEntries: 2, OnEvict: evicted.record})
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Get("a")
+	cache.Get("b")
+	cache.Put("c", 3)
+
+	if !slices.Equal(evicted, evictions{"a=1 (capacity)"}) {
+		t.Fatalf("evictions: got %v, want [a=1 (capacity)]", evicted)
+	}
+	if cache.Frequency("b") != 2 || cache.Frequency("c") != 1 {
+		t.Fatalf("frequencies: b %d, c %d", cache.Frequency("b"), cache.Frequency("c"))
+	}
+}
+
+/*
+TestLFUModel runs random operations against a model that counts the uses of every key and remembers its last use
+The victim must always be the key with the lowest count, on a tie the one used longest ago, and never the key just written
+*/
+func TestLFUModel(t *testing.T) {
+	random := rand.New(rand.NewPCG(3, 4))
+	const maxEntries = 4
+	var evicted []string
+	cache := NewLFU(Config[string, int]{
+		MaxEntries: maxEntries,
+		OnEvict:    func(key string, _ int, _ EvictReason) { evicted = append(evicted, key) },
+	})
+
+	type use struct{ freq, last int }
+	model := map[string]use{}
+	for step := range 5000 {
+		key := fmt.Sprint(random.IntN(8))
+		if random.IntN(2) == 0 {
+			if _, ok := cache.Get(key); ok != (model[key] != use{}) {
+				t.Fatalf("step %d: Get(%s) found %t", step, key, ok)
+			}
+			if u, ok := model[key]; ok {
+				model[key] = use{u.freq + 1, step}
+			}
+		} else {
+			cache.Put(key, step)
+			model[key] = use{model[key].freq + 1, step}
+			if len(model) > maxEntries {
+				victim := ""
+				for k, u := range model {
+					if k == key {
+						continue
+					}
+					if v, ok := model[victim]; victim == "" || !ok || u.freq < v.freq || u.freq == v.freq && u.last < v.last {
+						victim = k
+					}
+				}
+				if len(evicted) == 0 || evicted[len(evicted)-1] != victim {
+					t.Fatalf("step %d: evicted %v, want %s from %v", step, evicted, victim, model)
+				}
+				delete(model, victim)
+			}
+		}
+
+		for k, u := range model {
+			if cache.Frequency(k) != uint64(u.freq) {
+				t.Fatalf("step %d: Frequency(%s) = %d, want %d", step, k, cache.Frequency(k), u.freq)
+			}
+		}
+		if cache.Len() != len(model) {
+			t.Fatalf("step %d: Len %d, want %d", step, cache.Len(), len(model))
+		}
+	}
+}
+
+func TestLFUTTL(t *testing.T) {
+	c := &clock{now: time.Unix(1000, 0)}
+	cache := NewLFU(Config[string, int]{TTL: time.Second})
+	cache.nowFunc = c.Now
+
+	cache.Put("a", 1)
+	cache.PutWithTTL("b", 2, 0)
+	c.advance(time.Second)
+	if _, ok := cache.Get("a"); ok || cache.Frequency("a") != 0 {
+		t.Fatal("a did not expire at its TTL")
+	}
+	if removed := cache.PurgeExpired(); removed != 0 || cache.Len() != 1 {
+		t.Fatalf("PurgeExpired removed %d, Len %d", removed, cache.Len())
+	}
+	if stats := cache.Stats(); stats.Expirations != 1 || stats.Misses != 1 {
+		t.Fatalf("Stats: got %+v", stats)
+	}
+}
+
+// TestZeroValue checks that the zero caches work without New, with no limits and the real clock
+func TestZeroValue(t *testing.T) {
+	var cache Cache[string, int]
+	if _, ok := cache.Get("a"); ok || cache.PurgeExpired() != 0 {
+		t.Fatal("the zero Cache is not empty")
+	}
+	cache.Put("a", 1)
+	if v, ok := cache.Get("a"); !ok || v != 1 {
+		t.Fatalf("Cache: got %d, %t, want 1", v, ok)
+	}
+
+	var lfu LFU[string, int]
+	if _, ok := lfu.Get("a"); ok || lfu.PurgeExpired() != 0 {
+		t.Fatal("the zero LFU is not empty")
+	}
+	lfu.Put("a", 1)
+	if v, ok := lfu.Get("a"); !ok || v != 1 || lfu.Frequency("a") != 2 {
+		t.Fatalf("LFU: got %d, %t, want 1", v, ok)
+	}
+}
+
+// TestShardedConcurrent uses both sharded caches from many goroutines at once, run it with go test -race
+func TestShardedConcurrent(t *testing.T) {
+	const maxEntries = 64
+	constructors := map[string]func(int, func(string) uint64, Config[string, int]) *Sharded[string, int]{
+		"LRU": NewSharded[string, int],
+		"LFU": NewShardedLFU[string, int],
+	}
+
+	for name, constructor := range constructors {
+		t.Run(name, func(t *testing.T) {
+			cache := constructor(8, HashString, Config[string, int]{MaxEntries: maxEntries})
+			var wg sync.WaitGroup
+			for w := range 8 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					random := rand.New(rand.NewPCG(uint64(w), 1))
+					for i := range 2000 {
+						key := fmt.Sprint(random.IntN(200))
+						switch random.IntN(4) {
+						case 0:
+							cache.Delete(key)
+						case 1:
+							cache.Put(key, i)
+						default:
+							if v, ok := cache.Get(key); ok && v < 0 {
+								t.Errorf("Get(%s) returned %d", key, v)
+							}
+						}
+					}
+				}()
+			}
+			wg.Wait()
+
+			if cache.Len() > maxEntries {
+				t.Fatalf("Len %d, more than MaxEntries %d", cache.Len(), maxEntries)
+			}
+			if stats := cache.Stats(); stats.Hits+stats.Misses == 0 || stats.Evictions == 0 {
+				t.Fatalf("Stats: got %+v", stats)
+			}
+
+			cache.Purge()
+			if cache.Len() != 0 || cache.Bytes() != 0 {
+				t.Fatalf("Purge left %d entries", cache.Len())
+			}
+		})
+	}
+}
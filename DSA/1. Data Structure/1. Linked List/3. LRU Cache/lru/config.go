@@ -0,0 +1,99 @@
+/*
+Package lru provides generic in-memory caches built on the doubly linked list
+Cache evicts the least recently used entry, LFU evicts the least frequently used entry,
+and Sharded spreads the keys over several independently locked caches to reduce lock contention
+Every cache type is safe for concurrent use
+*/
+package lru
+
+import (
+	"time"
+)
+
+// EvictReason tells an OnEvict callback why an entry left the cache
+type EvictReason int
+
+const (
+	// ReasonCapacity means the entry was evicted to respect MaxEntries or MaxBytes
+	ReasonCapacity EvictReason = iota
+	// ReasonExpired means the entry outlived its TTL
+	ReasonExpired
+	// ReasonDeleted means the entry was removed by Delete or Purge
+	ReasonDeleted
+)
+
+func (reason EvictReason) String() string {
+	switch reason {
+	case ReasonCapacity:
+		return "capacity"
+	case ReasonExpired:
+		return "expired"
+	case ReasonDeleted:
+		return "deleted"
+	}
+	return "unknown"
+}
+
+/*
+Config holds the settings shared by Cache, LFU and Sharded
+MaxEntries limits the number of entries, 0 means no limit
+MaxBytes limits the total size reported by Sizer, 0 means no limit
+Sizer estimates the size of an entry in bytes, if it is nil every entry has size 0 and MaxBytes has no effect
+TTL is the default time to live of an entry, 0 means entries never expire
+OnEvict is called, with the cache lock held, every time an entry leaves the cache
+*/
+type Config[K comparable, V any] struct {
+	MaxEntries int
+	MaxBytes   int64
+	Sizer      func(key K, value V) int64
+	TTL        time.Duration
+	OnEvict    func(key K, value V, reason EvictReason)
+}
+
+// Stats holds the hit and miss counters of a cache
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64
+}
+
+// HitRatio returns Hits / (Hits + Misses), or 0 if the cache was never read
+func (stats Stats) HitRatio() float64 {
+	total := stats.Hits + stats.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(total)
+}
+
+// add returns the sum of two Stats, it is used to aggregate the shards of a Sharded cache
+func (stats Stats) add(other Stats) Stats {
+	return Stats{
+		Hits:        stats.Hits + other.Hits,
+		Misses:      stats.Misses + other.Misses,
+		Evictions:   stats.Evictions + other.Evictions,
+		Expirations: stats.Expirations + other.Expirations,
+	}
+}
+
+// size returns the estimated size of an entry using the configured Sizer
+func (config Config[K, V]) size(key K, value V) int64 {
+	if config.Sizer == nil {
+		return 0
+	}
+	return config.Sizer(key, value)
+}
+
+// expiry returns the expiry time for an entry stored now with the given ttl, the zero time means never
+func expiry(now time.Time, ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return now.Add(ttl)
+}
+
+// expired reports whether an entry with the given expiry time is expired at now
+func expired(expiresAt, now time.Time) bool {
+	return !expiresAt.IsZero() && !now.Before(expiresAt)
+}
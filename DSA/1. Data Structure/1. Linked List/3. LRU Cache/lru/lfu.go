@@ -0,0 +1,304 @@
+package lru
+
+import (
+	"sync"
+	"time"
+
+	"double/doublylinkedlist"
+)
+
+// lfuEntry is a single key/value pair together with the frequency bucket it currently belongs to
+type lfuEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	size      int64
+	expiresAt time.Time
+	bucket    *doublylinkedlist.Node[*bucket[K, V]]
+}
+
+// bucket groups every entry that was used exactly freq times, from most to least recently used
+type bucket[K comparable, V any] struct {
+	freq    uint64
+	entries doublylinkedlist.DoublyLinkedList[*lfuEntry[K, V]]
+}
+
+/*
+LFU is a least frequently used cache with O(1) operations
+The buckets live in a doubly linked list ordered by ascending frequency and each bucket holds its own list of entries
+Using an entry moves it from its bucket to the bucket with frequency + 1, creating that bucket right after the current one if needed
+The victim is always the least recently used entry of the first bucket, so ties between equal frequencies fall back to LRU
+The zero value is an empty cache without limits, ready to use
+*/
+type LFU[K comparable, V any] struct {
+	mu      sync.Mutex
+	config  Config[K, V]
+	buckets doublylinkedlist.DoublyLinkedList[*bucket[K, V]]
+	items   map[K]*doublylinkedlist.Node[*lfuEntry[K, V]]
+	bytes   int64
+	stats   Stats
+	nowFunc func() time.Time
+}
+
+// NewLFU returns an empty LFU cache using the given config
+func NewLFU[K comparable, V any](config Config[K, V]) *LFU[K, V] {
+	return &LFU[K, V]{
+		config:  config,
+		items:   make(map[K]*doublylinkedlist.Node[*lfuEntry[K, V]]),
+		nowFunc: time.Now,
+	}
+}
+
+/*
+Get returns the value stored for key and increments its frequency
+An expired entry is removed and reported as a miss
+*/
+func (cache *LFU[K, V]) Get(key K) (V, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	node, ok := cache.lookup(key)
+	if !ok {
+		cache.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	cache.stats.Hits++
+	cache.touch(node)
+	return node.Data.value, true
+}
+
+// Peek returns the value stored for key without changing its frequency or the statistics
+func (cache *LFU[K, V]) Peek(key K) (V, bool) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	node, ok := cache.lookup(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return node.Data.value, true
+}
+
+// Put stores value for key with the default TTL
+func (cache *LFU[K, V]) Put(key K, value V) {
+	cache.PutWithTTL(key, value, cache.config.TTL)
+}
+
+/*
+PutWithTTL stores value for key with its own time to live, 0 means the entry never expires
+Updating an existing key counts as a use and increments its frequency
+A new key is first made room for and then added to the frequency 1 bucket
+*/
+func (cache *LFU[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.items == nil {
+		cache.items = make(map[K]*doublylinkedlist.Node[*lfuEntry[K, V]])
+	}
+
+	size := cache.config.size(key, value)
+	expiresAt := expiry(cache.now(), ttl)
+
+	if node, ok := cache.items[key]; ok {
+		cache.bytes += size - node.Data.size
+		node.Data.value = value
+		node.Data.size = size
+		node.Data.expiresAt = expiresAt
+		cache.touch(node)
+		cache.evictOverflow(cache.items[key])
+		return
+	}
+
+	first := cache.buckets.FrontNode()
+	if first == nil || first.Data.freq != 1 {
+		first = cache.buckets.PushFront(&bucket[K, V]{freq: 1})
+	}
+
+	e := &lfuEntry[K, V]{key: key, value: value, size: size, expiresAt: expiresAt, bucket: first}
+	node := first.Data.entries.PushFront(e)
+	cache.items[key] = node
+	cache.bytes += size
+
+	cache.evictOverflow(node)
+}
+
+// Delete removes key from the cache and reports whether it was present
+func (cache *LFU[K, V]) Delete(key K) bool {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	node, ok := cache.items[key]
+	if !ok {
+		return false
+	}
+
+	cache.remove(node, ReasonDeleted)
+	return true
+}
+
+// Len returns the number of entries, including expired entries that were not removed yet
+func (cache *LFU[K, V]) Len() int {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	return len(cache.items)
+}
+
+// Bytes returns the total estimated size of the entries
+func (cache *LFU[K, V]) Bytes() int64 {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	return cache.bytes
+}
+
+// Frequency returns how many times key was used, or 0 if it is not in the cache
+func (cache *LFU[K, V]) Frequency(key K) uint64 {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	node, ok := cache.items[key]
+	if !ok {
+		return 0
+	}
+	return node.Data.bucket.Data.freq
+}
+
+// Stats returns a snapshot of the hit and miss counters
+func (cache *LFU[K, V]) Stats() Stats {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	return cache.stats
+}
+
+// PurgeExpired removes every expired entry and returns how many were removed
+func (cache *LFU[K, V]) PurgeExpired() int {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	now := cache.now()
+	removed := 0
+	for _, node := range cache.items {
+		if expired(node.Data.expiresAt, now) {
+			cache.remove(node, ReasonExpired)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Purge removes every entry from the cache, calling OnEvict for each of them
+func (cache *LFU[K, V]) Purge() {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	for _, node := range cache.items {
+		cache.remove(node, ReasonDeleted)
+	}
+}
+
+// now returns the current time from nowFunc, which tests replace with a fake clock, or from time.Now for the zero value
+func (cache *LFU[K, V]) now() time.Time {
+	if cache.nowFunc == nil {
+		return time.Now()
+	}
+	return cache.nowFunc()
+}
+
+// lookup returns the node of a live entry, removing it first if it has expired
+func (cache *LFU[K, V]) lookup(key K) (*doublylinkedlist.Node[*lfuEntry[K, V]], bool) {
+	node, ok := cache.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	if expired(node.Data.expiresAt, cache.now()) {
+		cache.remove(node, ReasonExpired)
+		return nil, false
+	}
+	return node, true
+}
+
+/*
+touch moves an entry to the bucket with the next frequency
+The next bucket is reused if it already has frequency + 1, otherwise a new one is inserted right after the current bucket
+The old bucket is dropped once it becomes empty
+*/
+func (cache *LFU[K, V]) touch(node *doublylinkedlist.Node[*lfuEntry[K, V]]) {
+	e := node.Data
+	current := e.bucket
+
+	next := current.Next()
+	if next == nil || next.Data.freq != current.Data.freq+1 {
+		next, _ = cache.buckets.InsertAfter(&bucket[K, V]{freq: current.Data.freq + 1}, current)
+	}
+
+	current.Data.entries.Remove(node)
+	cache.items[e.key] = next.Data.entries.PushFront(e)
+	e.bucket = next
+
+	if current.Data.entries.Len() == 0 {
+		cache.buckets.Remove(current)
+	}
+}
+
+/*
+evictOverflow removes entries until the cache fits its limits
+The victim is the least recently used entry of the lowest frequency bucket
+keep is the entry that was just written, it is only evicted when it is the last entry left
+*/
+func (cache *LFU[K, V]) evictOverflow(keep *doublylinkedlist.Node[*lfuEntry[K, V]]) {
+	for cache.overflow() {
+		victim := cache.buckets.FrontNode().Data.entries.BackNode()
+		if victim == keep && len(cache.items) > 1 {
+			victim = cache.nextVictim(keep)
+		}
+		cache.remove(victim, ReasonCapacity)
+	}
+}
+
+/*
+nextVictim returns the least frequently used entry other than keep
+It is only called when keep is alone in the lowest bucket, so the victim is the least recently used entry of the next bucket
+*/
+func (cache *LFU[K, V]) nextVictim(keep *doublylinkedlist.Node[*lfuEntry[K, V]]) *doublylinkedlist.Node[*lfuEntry[K, V]] {
+	return keep.Data.bucket.Next().Data.entries.BackNode()
+}
+
+// overflow reports whether the cache holds more than MaxEntries or MaxBytes
+func (cache *LFU[K, V]) overflow() bool {
+	if len(cache.items) == 0 {
+		return false
+	}
+
+	if cache.config.MaxEntries > 0 && len(cache.items) > cache.config.MaxEntries {
+		return true
+	}
+	return cache.config.MaxBytes > 0 && cache.bytes > cache.config.MaxBytes
+}
+
+// remove unlinks an entry from its bucket, drops empty buckets, updates the counters and calls OnEvict
+func (cache *LFU[K, V]) remove(node *doublylinkedlist.Node[*lfuEntry[K, V]], reason EvictReason) {
+	e := node.Data
+	e.bucket.Data.entries.Remove(node)
+	if e.bucket.Data.entries.Len() == 0 {
+		cache.buckets.Remove(e.bucket)
+	}
+	delete(cache.items, e.key)
+	cache.bytes -= e.size
+
+	switch reason {
+	case ReasonCapacity:
+		cache.stats.Evictions++
+	case ReasonExpired:
+		cache.stats.Expirations++
+	}
+
+	if cache.config.OnEvict != nil {
+		cache.config.OnEvict(e.key, e.value, reason)
+	}
+}
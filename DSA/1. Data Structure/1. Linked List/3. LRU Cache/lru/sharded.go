@@ -0,0 +1,144 @@
+package lru
+
+import (
+	"hash/maphash"
+	"time"
+)
+
+// store is the set of operations Sharded needs from each shard, both Cache and LFU implement it
+type store[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Peek(key K) (V, bool)
+	Put(key K, value V)
+	PutWithTTL(key K, value V, ttl time.Duration)
+	Delete(key K) bool
+	Len() int
+	Bytes() int64
+	Stats() Stats
+	PurgeExpired() int
+	Purge()
+}
+
+/*
+Sharded spreads the keys over several caches, each with its own lock
+Goroutines working on keys in different shards never wait for each other, which helps under heavy concurrent use
+The limits of the Config are divided evenly between the shards, so eviction is per shard and only approximately global
+*/
+type Sharded[K comparable, V any] struct {
+	shards []store[K, V]
+	hash   func(key K) uint64
+}
+
+// NewSharded returns a cache of LRU shards, hash must spread the keys evenly over uint64
+func NewSharded[K comparable, V any](shards int, hash func(key K) uint64, config Config[K, V]) *Sharded[K, V] {
+	return newSharded(shards, hash, config, func(config Config[K, V]) store[K, V] {
+		return New(config)
+	})
+}
+
+// NewShardedLFU returns a cache of LFU shards, hash must spread the keys evenly over uint64
+func NewShardedLFU[K comparable, V any](shards int, hash func(key K) uint64, config Config[K, V]) *Sharded[K, V] {
+	return newSharded(shards, hash, config, func(config Config[K, V]) store[K, V] {
+		return NewLFU(config)
+	})
+}
+
+func newSharded[K comparable, V any](shards int, hash func(key K) uint64, config Config[K, V], newStore func(Config[K, V]) store[K, V]) *Sharded[K, V] {
+	if shards < 1 {
+		shards = 1
+	}
+
+	shardConfig := config
+	if config.MaxEntries > 0 {
+		shardConfig.MaxEntries = max(1, config.MaxEntries/shards)
+	}
+	if config.MaxBytes > 0 {
+		shardConfig.MaxBytes = max(1, config.MaxBytes/int64(shards))
+	}
+
+	sharded := &Sharded[K, V]{shards: make([]store[K, V], shards), hash: hash}
+	for i := range sharded.shards {
+		sharded.shards[i] = newStore(shardConfig)
+	}
+	return sharded
+}
+
+var stringSeed = maphash.MakeSeed()
+
+// HashString is a ready made hash function for string keys
+func HashString(key string) uint64 {
+	return maphash.String(stringSeed, key)
+}
+
+// shard returns the shard responsible for key
+func (cache *Sharded[K, V]) shard(key K) store[K, V] {
+	return cache.shards[cache.hash(key)%uint64(len(cache.shards))]
+}
+
+// Get returns the value stored for key
+func (cache *Sharded[K, V]) Get(key K) (V, bool) {
+	return cache.shard(key).Get(key)
+}
+
+// Peek returns the value stored for key without touching it
+func (cache *Sharded[K, V]) Peek(key K) (V, bool) {
+	return cache.shard(key).Peek(key)
+}
+
+// Put stores value for key with the default TTL
+func (cache *Sharded[K, V]) Put(key K, value V) {
+	cache.shard(key).Put(key, value)
+}
+
+// PutWithTTL stores value for key with its own time to live
+func (cache *Sharded[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	cache.shard(key).PutWithTTL(key, value, ttl)
+}
+
+// Delete removes key from the cache and reports whether it was present
+func (cache *Sharded[K, V]) Delete(key K) bool {
+	return cache.shard(key).Delete(key)
+}
+
+// Len returns the number of entries over all shards
+func (cache *Sharded[K, V]) Len() int {
+	n := 0
+	for _, shard := range cache.shards {
+		n += shard.Len()
+	}
+	return n
+}
+
+// Bytes returns the total estimated size of the entries over all shards
+func (cache *Sharded[K, V]) Bytes() int64 {
+	var n int64
+	for _, shard := range cache.shards {
+		n += shard.Bytes()
+	}
+	return n
+}
+
+// Stats returns the sum of the counters of all shards
+func (cache *Sharded[K, V]) Stats() Stats {
+	var stats Stats
+	for _, shard := range cache.shards {
+		stats = stats.add(shard.Stats())
+	}
+	return stats
+}
+
+// PurgeExpired removes every expired entry from all shards and returns how many were removed
+func (cache *Sharded[K, V]) PurgeExpired() int {
+	removed := 0
+	for _, shard := range cache.shards {
+		removed += shard.PurgeExpired()
+	}
+	return removed
+}
+
+// Purge removes every entry from all shards
+func (cache *Sharded[K, V]) Purge() {
+	for _, shard := range cache.shards {
+		shard.Purge()
+	}
+}
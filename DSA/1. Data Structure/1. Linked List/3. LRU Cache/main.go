@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"lrucache/lru"
+)
+
+func main() {
+	// LRU cache limited to 3 entries
+	cache := lru.New(lru.Config[string, int]{
+		MaxEntries: 3,
+		OnEvict: func(key string, value int, reason lru.EvictReason) {
+			fmt.Printf("Evicted %s=%d (%v) \n", key, value, reason)
+		},
+	})
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Get("a")
+	cache.Put("d", 4)
+	if _, ok := cache.Get("b"); !ok {
+		fmt.Println("b was the least recently used")
+	}
+	fmt.Println("Keys :", cache.Keys())
+
+	// Entries with a time to live
+	cache.PutWithTTL("session", 42, 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := cache.Get("session"); !ok {
+		fmt.Println("session expired")
+	}
+
+	// Capacity by estimated size in bytes
+	pages := lru.New(lru.Config[string, []byte]{
+		MaxBytes: 1024,
+		Sizer: func(key string, value []byte) int64 {
+			return int64(len(key) + len(value))
+		},
+	})
+	pages.Put("/home", make([]byte, 600))
+	pages.Put("/about", make([]byte, 600))
+	fmt.Println("Pages :", pages.Len(), "Bytes :", pages.Bytes())
+
+	// LFU cache keeps the most frequently used entries
+	lfu := lru.NewLFU(lru.Config[string, int]{MaxEntries: 2})
+	lfu.Put("x", 1)
+	lfu.Put("y", 2)
+	lfu.Get("x")
+	lfu.Get("x")
+	lfu.Put("z", 3)
+	_, hasY := lfu.Get("y")
+	fmt.Println("x used", lfu.Frequency("x"), "times, y still cached :", hasY)
+
+	// Sharded cache shared by many goroutines
+	sharded := lru.NewSharded(8, lru.HashString, lru.Config[string, int]{MaxEntries: 1000})
+	var wg sync.WaitGroup
+	for worker := 0; worker < 4; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				key := fmt.Sprint("key-", i%100)
+				if _, ok := sharded.Get(key); !ok {
+					sharded.Put(key, worker)
+				}
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	stats := sharded.Stats()
+	fmt.Printf("Hits : %d Misses : %d Ratio : %.2f \n", stats.Hits, stats.Misses, stats.HitRatio())
+}
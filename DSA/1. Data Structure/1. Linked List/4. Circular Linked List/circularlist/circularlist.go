@@ -0,0 +1,534 @@
+/*
+Package circularlist provides generic circular linked lists
+In a circular list the last node links back to the first one, so there is no nil at the end
+CircularList is singly linked and DoublyCircularList is doubly linked, both share the insert/update/delete API
+of the linear LinkedList and add a moving cursor for round-robin traversal (Advance, Current, RemoveCurrent)
+A CircularList created with NewRing is bounded and overwrites its oldest entry when it is full
+*/
+package circularlist
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+)
+
+// Node represents a single node in the circular list, Next is never nil while the node is in a list
+type Node[T comparable] struct {
+	Data T
+	Next *Node[T]
+}
+
+/*
+CircularList represents a singly linked circular list
+Only the tail is stored, the head is always tail.Next, so both ends are reachable in O(1)
+The cursor is stored as the node before it (before.Next is the current node), which lets RemoveCurrent run in O(1)
+A capacity greater than 0 turns the list into a ring buffer, see NewRing
+*/
+type CircularList[T comparable] struct {
+	tail     *Node[T]
+	before   *Node[T]
+	size     int
+	capacity int
+	Compare  func(a, b T) int
+}
+
+// New returns an empty list of an ordered type that uses cmp.Compare for the ordered operations
+func New[T cmp.Ordered]() *CircularList[T] {
+	return &CircularList[T]{Compare: cmp.Compare[T]}
+}
+
+// NewFunc returns an empty list that uses the given compare function for the ordered operations
+func NewFunc[T comparable](compare func(a, b T) int) *CircularList[T] {
+	return &CircularList[T]{Compare: compare}
+}
+
+/*
+NewRing returns an empty ring buffer that holds at most capacity values
+The front of the ring is always the oldest value, when an insert makes the ring exceed its capacity
+the front value is dropped, so PushBack on a full ring overwrites the oldest entry
+*/
+func NewRing[T comparable](capacity int) *CircularList[T] {
+	return &CircularList[T]{capacity: capacity}
+}
+
+/*
+insertAfter links a new node holding data right after prev and returns it
+prev is ignored when the list is empty, the new node then links to itself
+If the new node lands between the cursor and the node before it, the cursor stays on its current node
+*/
+func (list *CircularList[T]) insertAfter(prev *Node[T], data T) *Node[T] {
+	node := &Node[T]{Data: data}
+	if list.size == 0 {
+		node.Next = node
+		list.tail = node
+		list.before = node
+		list.size = 1
+		return node
+	}
+
+	node.Next = prev.Next
+	prev.Next = node
+	if prev == list.before {
+		list.before = node
+	}
+	list.size++
+	return node
+}
+
+/*
+removeAfter unlinks the node right after prev and returns it
+The tail and the cursor are moved back to prev if they pointed at the removed node
+If the cursor was on the removed node it moves on to the next one
+*/
+func (list *CircularList[T]) removeAfter(prev *Node[T]) *Node[T] {
+	node := prev.Next
+	if node == prev {
+		list.tail = nil
+		list.before = nil
+		list.size = 0
+		node.Next = nil
+		return node
+	}
+
+	prev.Next = node.Next
+	if list.tail == node {
+		list.tail = prev
+	}
+	if list.before == node {
+		list.before = prev
+	}
+	node.Next = nil
+	list.size--
+	return node
+}
+
+// trim drops the oldest values while a ring buffer holds more than its capacity
+func (list *CircularList[T]) trim() {
+	for list.capacity > 0 && list.size > list.capacity {
+		list.removeAfter(list.tail)
+	}
+}
+
+// nodeBefore returns the node before the given index, index 0 gives the tail
+func (list *CircularList[T]) nodeBefore(index int) *Node[T] {
+	prev := list.tail
+	for i := 0; i < index; i++ {
+		prev = prev.Next
+	}
+	return prev
+}
+
+// findBefore returns the node before the first node holding data, or nil
+func (list *CircularList[T]) findBefore(data T) *Node[T] {
+	prev := list.tail
+	for i := 0; i < list.size; i++ {
+		if prev.Next.Data == data {
+			return prev
+		}
+		prev = prev.Next
+	}
+	return nil
+}
+
+// Len returns the number of nodes in O(1)
+func (list *CircularList[T]) Len() int {
+	return list.size
+}
+
+// Cap returns the capacity of a ring buffer, or 0 for an unbounded list
+func (list *CircularList[T]) Cap() int {
+	return list.capacity
+}
+
+// IsFull reports whether a ring buffer holds as many values as its capacity, an unbounded list is never full
+func (list *CircularList[T]) IsFull() bool {
+	return list.capacity > 0 && list.size == list.capacity
+}
+
+// Front returns the data of the first node, or ErrEmpty
+func (list *CircularList[T]) Front() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.tail.Next.Data, nil
+}
+
+// Back returns the data of the last node, or ErrEmpty
+func (list *CircularList[T]) Back() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.tail.Data, nil
+}
+
+/*
+Print prints one lap of the circular list
+It starts from the head (tail.Next) and stops when it is back at the head
+*/
+func (list *CircularList[T]) Print() {
+	for v := range list.Values() {
+		fmt.Println("Data is :", v)
+	}
+}
+
+/*
+InsertAtBack inserts a new node after the tail and makes it the new tail in O(1)
+On a full ring buffer the oldest value is dropped
+*/
+func (list *CircularList[T]) InsertAtBack(data T) {
+	list.tail = list.insertAfter(list.tail, data)
+	list.trim()
+}
+
+// PushBack is the same as InsertAtBack
+func (list *CircularList[T]) PushBack(data T) {
+	list.InsertAtBack(data)
+}
+
+/*
+InsertAtFront inserts a new node after the tail without moving the tail, so it becomes the new head in O(1)
+On a full ring buffer the new value is itself the oldest one, so it is dropped straight away
+*/
+func (list *CircularList[T]) InsertAtFront(data T) {
+	list.insertAfter(list.tail, data)
+	list.trim()
+}
+
+// PopFront removes the head in O(1) and returns its data, or ErrEmpty
+func (list *CircularList[T]) PopFront() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.removeAfter(list.tail).Data, nil
+}
+
+/*
+InsertAfterValue inserts a new node after the first node holding afterValue
+If the list is empty, it returns ErrEmpty
+If no node holds afterValue, it returns ErrNotFound
+*/
+func (list *CircularList[T]) InsertAfterValue(afterValue, data T) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	prev := list.findBefore(afterValue)
+	if prev == nil {
+		return ErrNotFound
+	}
+
+	node := prev.Next
+	inserted := list.insertAfter(node, data)
+	if node == list.tail {
+		list.tail = inserted
+	}
+	list.trim()
+	return nil
+}
+
+/*
+InsertBeforeValue inserts a new node before the first node holding beforeValue
+If the list is empty, it returns ErrEmpty
+If no node holds beforeValue, it returns ErrNotFound
+*/
+func (list *CircularList[T]) InsertBeforeValue(beforeValue, data T) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	prev := list.findBefore(beforeValue)
+	if prev == nil {
+		return ErrNotFound
+	}
+
+	list.insertAfter(prev, data)
+	list.trim()
+	return nil
+}
+
+/*
+InsertInSortedList inserts a new node in a sorted circular list
+Equal values are inserted after the existing ones
+If the list has no Compare function, it returns ErrNoComparator
+*/
+func (list *CircularList[T]) InsertInSortedList(data T) error {
+	if list.Compare == nil {
+		return ErrNoComparator
+	}
+
+	if list.size == 0 || list.Compare(list.tail.Data, data) <= 0 {
+		list.InsertAtBack(data)
+		return nil
+	}
+
+	prev := list.tail
+	for list.Compare(prev.Next.Data, data) <= 0 {
+		prev = prev.Next
+	}
+
+	list.insertAfter(prev, data)
+	list.trim()
+	return nil
+}
+
+/*
+InsertAtSpecficPosition inserts a new node so that it ends up at the given position
+Position 0 inserts at the front and position Len() inserts at the back
+If the position is negative or greater than the length of the list, it returns ErrIndexOutOfRange
+*/
+func (list *CircularList[T]) InsertAtSpecficPosition(data T, position int) error {
+	if position < 0 || position > list.size {
+		return ErrIndexOutOfRange
+	}
+
+	if position == list.size {
+		list.InsertAtBack(data)
+		return nil
+	}
+
+	list.insertAfter(list.nodeBefore(position), data)
+	list.trim()
+	return nil
+}
+
+/*
+UpdateValueByOldValue updates the first node holding oldValue to newValue
+If the list is empty, it returns ErrEmpty
+If no node holds oldValue, it returns ErrNotFound
+*/
+func (list *CircularList[T]) UpdateValueByOldValue(oldValue, newValue T) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	prev := list.findBefore(oldValue)
+	if prev == nil {
+		return ErrNotFound
+	}
+
+	prev.Next.Data = newValue
+	return nil
+}
+
+/*
+UpdateAllValueByOldValue updates every node holding oldValue to newValue
+It returns the number of updated nodes, ErrEmpty if the list is empty and ErrNotFound if nothing matched
+*/
+func (list *CircularList[T]) UpdateAllValueByOldValue(oldValue, newValue T) (int, error) {
+	if list.size == 0 {
+		return 0, ErrEmpty
+	}
+
+	updated := 0
+	current := list.tail.Next
+	for i := 0; i < list.size; i++ {
+		if current.Data == oldValue {
+			current.Data = newValue
+			updated++
+		}
+		current = current.Next
+	}
+
+	if updated == 0 {
+		return 0, ErrNotFound
+	}
+	return updated, nil
+}
+
+/*
+UpdateByPosition updates the value of the node at the given position
+If the list is empty, it returns ErrEmpty
+If the position is negative or not smaller than the length of the list, it returns ErrIndexOutOfRange
+*/
+func (list *CircularList[T]) UpdateByPosition(data T, position int) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	if position < 0 || position >= list.size {
+		return ErrIndexOutOfRange
+	}
+
+	list.nodeBefore(position).Next.Data = data
+	return nil
+}
+
+/*
+DeleteByValue deletes the first node holding data
+If the list is empty, it returns ErrEmpty
+If no node holds data, it returns ErrNotFound
+*/
+func (list *CircularList[T]) DeleteByValue(data T) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	prev := list.findBefore(data)
+	if prev == nil {
+		return ErrNotFound
+	}
+
+	list.removeAfter(prev)
+	return nil
+}
+
+/*
+DeleteByIndex deletes the node at the given index
+If the list is empty, it returns ErrEmpty
+If the index is negative or not smaller than the length of the list, it returns ErrIndexOutOfRange
+*/
+func (list *CircularList[T]) DeleteByIndex(index int) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	if index < 0 || index >= list.size {
+		return ErrIndexOutOfRange
+	}
+
+	list.removeAfter(list.nodeBefore(index))
+	return nil
+}
+
+/*
+DeleteAllByValue deletes every node holding value
+It walks exactly one lap, so it stops even though the list has no end
+It returns the number of deleted nodes, ErrEmpty if the list is empty and ErrNotFound if nothing matched
+*/
+func (list *CircularList[T]) DeleteAllByValue(value T) (int, error) {
+	if list.size == 0 {
+		return 0, ErrEmpty
+	}
+
+	deleted := 0
+	prev := list.tail
+	for remaining := list.size; remaining > 0 && list.size > 0; remaining-- {
+		if prev.Next.Data == value {
+			list.removeAfter(prev)
+			deleted++
+			continue
+		}
+		prev = prev.Next
+	}
+
+	if deleted == 0 {
+		return 0, ErrNotFound
+	}
+	return deleted, nil
+}
+
+// FindIndexByValue returns the position of the first node holding data, or ErrNotFound
+func (list *CircularList[T]) FindIndexByValue(data T) (int, error) {
+	current := list.head()
+	for i := 0; i < list.size; i++ {
+		if current.Data == data {
+			return i, nil
+		}
+		current = current.Next
+	}
+	return -1, ErrNotFound
+}
+
+// head returns the first node, or nil if the list is empty
+func (list *CircularList[T]) head() *Node[T] {
+	if list.tail == nil {
+		return nil
+	}
+	return list.tail.Next
+}
+
+// Values returns an iterator over one lap of the list, from the head to the tail
+func (list *CircularList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		current := list.head()
+		for i := 0; i < list.size; i++ {
+			if !yield(current.Data) {
+				return
+			}
+			current = current.Next
+		}
+	}
+}
+
+// Current returns the data of the node under the cursor, or ErrEmpty
+func (list *CircularList[T]) Current() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.before.Next.Data, nil
+}
+
+/*
+Advance moves the cursor n nodes forward and returns the data of the new current node
+A singly linked list can only move forward, so a negative n moves size - |n| steps forward instead
+Only n modulo the length of the list steps are taken
+If the list is empty, it returns ErrEmpty
+*/
+func (list *CircularList[T]) Advance(n int) (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	n %= list.size
+	if n < 0 {
+		n += list.size
+	}
+	for i := 0; i < n; i++ {
+		list.before = list.before.Next
+	}
+	return list.before.Next.Data, nil
+}
+
+/*
+RemoveCurrent removes the node under the cursor in O(1) and returns its data
+The cursor moves on to the next node, which makes Josephus style eliminations a loop of Advance and RemoveCurrent
+If the list is empty, it returns ErrEmpty
+*/
+func (list *CircularList[T]) RemoveCurrent() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.removeAfter(list.before).Data, nil
+}
+
+// ResetCursor moves the cursor back to the head
+func (list *CircularList[T]) ResetCursor() {
+	list.before = list.tail
+}
+
+/*
+Next returns the data under the cursor and then moves the cursor one node forward
+Calling it repeatedly hands out the values in round-robin order, which is how a scheduler picks the next task
+If the list is empty, it returns ErrEmpty
+*/
+func (list *CircularList[T]) Next() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	list.before = list.before.Next
+	return list.before.Data, nil
+}
+
+/*
+RoundRobin returns an endless iterator that calls Next on every step
+The loop must be stopped by the caller with break, or ends by itself once the list becomes empty
+*/
+func (list *CircularList[T]) RoundRobin() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			v, err := list.Next()
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,336 @@
+package circularlist_test
+
+import (
+	"errors"
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"circular/circularlist"
+	"generic/linkedlist/listtest"
+)
+
+// errs are the sentinel errors of the circularlist package, in the shape listtest expects
+var errs = listtest.Errors{
+	NotFound:        circularlist.ErrNotFound,
+	IndexOutOfRange: circularlist.ErrIndexOutOfRange,
+	Empty:           circularlist.ErrEmpty,
+	NoComparator:    circularlist.ErrNoComparator,
+}
+
+/*
+findMax is the FindMax the circular lists do not have, listtest needs it to find out whether a list has a Compare function
+It returns ErrNoComparator without one, so the model expects InsertInSortedList to fail the same way
+*/
+func findMax(compare func(a, b int) int, values iter.Seq[int]) (int, error) {
+	if compare == nil {
+		return 0, circularlist.ErrNoComparator
+	}
+	s := slices.Collect(values)
+	if len(s) == 0 {
+		return 0, circularlist.ErrEmpty
+	}
+	return slices.MaxFunc(s, compare), nil
+}
+
+// circular adds FindMax to a CircularList
+type circular struct {
+	*circularlist.CircularList[int]
+}
+
+func (list circular) FindMax() (int, error) { return findMax(list.Compare, list.Values()) }
+
+// doublyCircular adds FindMax to a DoublyCircularList
+type doublyCircular struct {
+	*circularlist.DoublyCircularList[int]
+}
+
+func (list doublyCircular) FindMax() (int, error) { return findMax(list.Compare, list.Values()) }
+
+/*
+TestList runs the suite of the singly linked list on both circular lists
+The zero values have no Compare function, so they check that the ordered operations return ErrNoComparator
+*/
+func TestList(t *testing.T) {
+	t.Run("CircularList", func(t *testing.T) {
+		listtest.Run(t, func() circular { return circular{circularlist.New[int]()} }, errs)
+	})
+	t.Run("UnorderedCircularList", func(t *testing.T) {
+		listtest.Run(t, func() circular { return circular{&circularlist.CircularList[int]{}} }, errs)
+	})
+	t.Run("DoublyCircularList", func(t *testing.T) {
+		listtest.Run(t, func() doublyCircular { return doublyCircular{circularlist.NewDoubly[int]()} }, errs)
+	})
+	t.Run("UnorderedDoublyCircularList", func(t *testing.T) {
+		listtest.Run(t, func() doublyCircular { return doublyCircular{&circularlist.DoublyCircularList[int]{}} }, errs)
+	})
+}
+
+func FuzzList(f *testing.F) {
+	listtest.Fuzz(f, func() circular { return circular{circularlist.New[int]()} }, errs)
+}
+
+// cursorList is the API both circular lists share around the cursor
+type cursorList interface {
+	Len() int
+	Values() iter.Seq[int]
+	PushBack(data int)
+	InsertAtFront(data int)
+	PopFront() (int, error)
+	DeleteByValue(data int) error
+	Current() (int, error)
+	Advance(n int) (int, error)
+	RemoveCurrent() (int, error)
+	ResetCursor()
+	Next() (int, error)
+	RoundRobin() iter.Seq[int]
+}
+
+// lists returns a new empty list of every kind, the ring keeps only its last 5 values
+func lists() map[string]struct {
+	list     cursorList
+	capacity int
+} {
+	return map[string]struct {
+		list     cursorList
+		capacity int
+	}{
+		"CircularList":       {circularlist.New[int](), 0},
+		"DoublyCircularList": {circularlist.NewDoubly[int](), 0},
+		"Ring":               {circularlist.NewRing[int](5), 5},
+	}
+}
+
+/*
+cursorModel is a slice together with the index of the node under the cursor
+The cursor stays on its node while other nodes come and go, and moves on to the next node when its own node is removed
+*/
+type cursorModel struct {
+	values   []int
+	at       int
+	capacity int
+}
+
+func (m *cursorModel) insert(index, data int) {
+	m.values = slices.Insert(m.values, index, data)
+	if len(m.values) > 1 && index <= m.at {
+		m.at++
+	}
+	for m.capacity > 0 && len(m.values) > m.capacity {
+		m.remove(0)
+	}
+}
+
+func (m *cursorModel) remove(index int) int {
+	data := m.values[index]
+	m.values = slices.Delete(m.values, index, index+1)
+	if index < m.at {
+		m.at--
+	}
+	if m.at == len(m.values) {
+		m.at = 0
+	}
+	return data
+}
+
+// TestCursor runs random inserts, removals and cursor moves against the model and checks the cursor after every step
+func TestCursor(t *testing.T) {
+	for name, kind := range lists() {
+		t.Run(name, func(t *testing.T) {
+			random := rand.New(rand.NewPCG(1, 2))
+			list, m := kind.list, &cursorModel{capacity: kind.capacity}
+
+			for step := range 5000 {
+				data := random.IntN(8)
+				switch random.IntN(8) {
+				case 0:
+					list.PushBack(data)
+					m.insert(len(m.values), data)
+				case 1:
+					list.InsertAtFront(data)
+					m.insert(0, data)
+				case 2:
+					got, err := list.PopFront()
+					if len(m.values) == 0 {
+						if !errors.Is(err, circularlist.ErrEmpty) {
+							t.Fatalf("step %d: PopFront of an empty list returned %v", step, err)
+						}
+						break
+					}
+					if want := m.remove(0); err != nil || got != want {
+						t.Fatalf("step %d: PopFront returned %d, %v, want %d", step, got, err, want)
+					}
+				case 3:
+					err := list.DeleteByValue(data)
+					i := slices.Index(m.values, data)
+					if i < 0 {
+						want := circularlist.ErrNotFound
+						if len(m.values) == 0 {
+							want = circularlist.ErrEmpty
+						}
+						if !errors.Is(err, want) {
+							t.Fatalf("step %d: DeleteByValue(%d) of %v returned %v", step, data, m.values, err)
+						}
+						break
+					}
+					m.remove(i)
+					if err != nil {
+						t.Fatalf("step %d: DeleteByValue(%d): %v", step, data, err)
+					}
+				case 4:
+					n := random.IntN(21) - 10
+					got, err := list.Advance(n)
+					if len(m.values) > 0 {
+						m.at = ((m.at+n)%len(m.values) + len(m.values)) % len(m.values)
+						if want := m.values[m.at]; err != nil || got != want {
+							t.Fatalf("step %d: Advance(%d) returned %d, %v, want %d", step, n, got, err, want)
+						}
+					} else if !errors.Is(err, circularlist.ErrEmpty) {
+						t.Fatalf("step %d: Advance of an empty list returned %v", step, err)
+					}
+				case 5:
+					got, err := list.RemoveCurrent()
+					if len(m.values) == 0 {
+						if !errors.Is(err, circularlist.ErrEmpty) {
+							t.Fatalf("step %d: RemoveCurrent of an empty list returned %v", step, err)
+						}
+						break
+					}
+					if want := m.remove(m.at); err != nil || got != want {
+						t.Fatalf("step %d: RemoveCurrent returned %d, %v, want %d", step, got, err, want)
+					}
+				case 6:
+					got, err := list.Next()
+					if len(m.values) == 0 {
+						if !errors.Is(err, circularlist.ErrEmpty) {
+							t.Fatalf("step %d: Next of an empty list returned %v", step, err)
+						}
+						break
+					}
+					if want := m.values[m.at]; err != nil || got != want {
+						t.Fatalf("step %d: Next returned %d, %v, want %d", step, got, err, want)
+					}
+					m.at = (m.at + 1) % len(m.values)
+				case 7:
+					list.ResetCursor()
+					m.at = 0
+				}
+
+				if got := slices.Collect(list.Values()); !slices.Equal(got, m.values) || list.Len() != len(m.values) {
+					t.Fatalf("step %d: got %v with Len %d, want %v", step, got, list.Len(), m.values)
+				}
+				current, err := list.Current()
+				if len(m.values) == 0 && !errors.Is(err, circularlist.ErrEmpty) {
+					t.Fatalf("step %d: Current of an empty list returned %v", step, err)
+				}
+				if len(m.values) > 0 && (err != nil || current != m.values[m.at]) {
+					t.Fatalf("step %d: Current returned %d, %v, want %d of %v", step, current, err, m.values[m.at], m.values)
+				}
+			}
+		})
+	}
+}
+
+// TestJosephus eliminates every third of seven people with Advance and RemoveCurrent, which ends the list
+func TestJosephus(t *testing.T) {
+	for name, kind := range lists() {
+		if kind.capacity > 0 {
+			continue
+		}
+		list := kind.list
+		for i := 1; i <= 7; i++ {
+			list.PushBack(i)
+		}
+
+		var order []int
+		for list.Len() > 0 {
+			list.Advance(2)
+			person, _ := list.RemoveCurrent()
+			order = append(order, person)
+		}
+		if want := []int{3, 6, 2, 7, 5, 1, 4}; !slices.Equal(order, want) {
+			t.Fatalf("%s: got %v, want %v", name, order, want)
+		}
+		if _, err := list.Current(); !errors.Is(err, circularlist.ErrEmpty) {
+			t.Fatalf("%s: Current after the last elimination returned %v", name, err)
+		}
+	}
+}
+
+// TestRoundRobin checks that RoundRobin hands out the values in turn, leaves the cursor after the last one and ends on an empty list
+func TestRoundRobin(t *testing.T) {
+	for name, kind := range lists() {
+		list := kind.list
+		for i := 1; i <= 3; i++ {
+			list.PushBack(i)
+		}
+
+		var turns []int
+		for v := range list.RoundRobin() {
+			turns = append(turns, v)
+			if len(turns) == 7 {
+				break
+			}
+		}
+		if want := []int{1, 2, 3, 1, 2, 3, 1}; !slices.Equal(turns, want) {
+			t.Fatalf("%s: got %v, want %v", name, turns, want)
+		}
+		if current, _ := list.Current(); current != 2 {
+			t.Fatalf("%s: the cursor is on %d after the break, want 2", name, current)
+		}
+
+		// Every turn removes its own value, so the loop must stop by itself once the list is empty
+		turns = nil
+		for v := range list.RoundRobin() {
+			turns = append(turns, v)
+			list.DeleteByValue(v)
+		}
+		if want := []int{2, 3, 1}; !slices.Equal(turns, want) || list.Len() != 0 {
+			t.Fatalf("%s: got %v and %d values left, want %v", name, turns, list.Len(), want)
+		}
+	}
+}
+
+// TestRing checks that a full ring drops its oldest value on PushBack and the new value itself on InsertAtFront
+func TestRing(t *testing.T) {
+	ring := circularlist.NewRing[int](3)
+	if ring.Cap() != 3 || ring.IsFull() {
+		t.Fatalf("new ring: Cap %d, IsFull %t", ring.Cap(), ring.IsFull())
+	}
+
+	for i := 1; i <= 5; i++ {
+		ring.PushBack(i)
+		if want := min(i, 3); ring.Len() != want || ring.IsFull() != (i >= 3) {
+			t.Fatalf("after %d pushes: Len %d, IsFull %t, want %d values", i, ring.Len(), ring.IsFull(), want)
+		}
+	}
+	if got := slices.Collect(ring.Values()); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Fatalf("got %v, want the last three values [3 4 5]", got)
+	}
+	if front, _ := ring.Front(); front != 3 {
+		t.Fatalf("Front is %d, want the oldest value 3", front)
+	}
+
+	ring.InsertAtFront(0)
+	if got := slices.Collect(ring.Values()); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Fatalf("InsertAtFront on a full ring: got %v, want [3 4 5]", got)
+	}
+
+	ring.PopFront()
+	if ring.IsFull() {
+		t.Fatal("a ring is still full after PopFront")
+	}
+	ring.PushBack(6)
+	if got := slices.Collect(ring.Values()); !slices.Equal(got, []int{4, 5, 6}) {
+		t.Fatalf("got %v, want [4 5 6]", got)
+	}
+
+	unbounded := circularlist.New[int]()
+	for i := range 100 {
+		unbounded.PushBack(i)
+	}
+	if unbounded.Cap() != 0 || unbounded.IsFull() || unbounded.Len() != 100 {
+		t.Fatalf("unbounded list: Cap %d, IsFull %t, Len %d", unbounded.Cap(), unbounded.IsFull(), unbounded.Len())
+	}
+}
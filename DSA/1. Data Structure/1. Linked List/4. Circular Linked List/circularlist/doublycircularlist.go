@@ -0,0 +1,527 @@
+package circularlist
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+)
+
+// DoublyNode represents a single node in the doubly circular list, Next and Prev are never nil while the node is in a list
+type DoublyNode[T comparable] struct {
+	Data T
+	Next *DoublyNode[T]
+	Prev *DoublyNode[T]
+}
+
+/*
+DoublyCircularList represents a doubly linked circular list
+The head's Prev is the tail and the tail's Next is the head, so both ends are reachable in O(1)
+The cursor can move both ways, a negative Advance walks backwards along the Prev links
+*/
+type DoublyCircularList[T comparable] struct {
+	head    *DoublyNode[T]
+	cursor  *DoublyNode[T]
+	size    int
+	Compare func(a, b T) int
+}
+
+// NewDoubly returns an empty doubly circular list of an ordered type that uses cmp.Compare for the ordered operations
+func NewDoubly[T cmp.Ordered]() *DoublyCircularList[T] {
+	return &DoublyCircularList[T]{Compare: cmp.Compare[T]}
+}
+
+// NewDoublyFunc returns an empty doubly circular list that uses the given compare function for the ordered operations
+func NewDoublyFunc[T comparable](compare func(a, b T) int) *DoublyCircularList[T] {
+	return &DoublyCircularList[T]{Compare: compare}
+}
+
+/*
+insertBefore links a new node holding data right before mark and returns it
+mark is ignored when the list is empty, the new node then links to itself and becomes the head and the cursor
+*/
+func (list *DoublyCircularList[T]) insertBefore(mark *DoublyNode[T], data T) *DoublyNode[T] {
+	node := &DoublyNode[T]{Data: data}
+	if list.size == 0 {
+		node.Next = node
+		node.Prev = node
+		list.head = node
+		list.cursor = node
+		list.size = 1
+		return node
+	}
+
+	node.Next = mark
+	node.Prev = mark.Prev
+	mark.Prev.Next = node
+	mark.Prev = node
+	list.size++
+	return node
+}
+
+/*
+unlink removes node from the list
+The head and the cursor move on to the next node if they pointed at the removed node
+*/
+func (list *DoublyCircularList[T]) unlink(node *DoublyNode[T]) {
+	if list.size == 1 {
+		list.head = nil
+		list.cursor = nil
+	} else {
+		node.Prev.Next = node.Next
+		node.Next.Prev = node.Prev
+		if list.head == node {
+			list.head = node.Next
+		}
+		if list.cursor == node {
+			list.cursor = node.Next
+		}
+	}
+
+	node.Next = nil
+	node.Prev = nil
+	list.size--
+}
+
+/*
+nodeAt returns the node at the given index
+It walks from whichever end is closer, so it needs at most n/2 steps
+*/
+func (list *DoublyCircularList[T]) nodeAt(index int) *DoublyNode[T] {
+	if index <= list.size/2 {
+		current := list.head
+		for i := 0; i < index; i++ {
+			current = current.Next
+		}
+		return current
+	}
+
+	current := list.head
+	for i := list.size; i > index; i-- {
+		current = current.Prev
+	}
+	return current
+}
+
+// find returns the first node holding data, or nil
+func (list *DoublyCircularList[T]) find(data T) *DoublyNode[T] {
+	current := list.head
+	for i := 0; i < list.size; i++ {
+		if current.Data == data {
+			return current
+		}
+		current = current.Next
+	}
+	return nil
+}
+
+// Len returns the number of nodes in O(1)
+func (list *DoublyCircularList[T]) Len() int {
+	return list.size
+}
+
+// Front returns the data of the head, or ErrEmpty
+func (list *DoublyCircularList[T]) Front() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.head.Data, nil
+}
+
+// Back returns the data of the tail (head.Prev), or ErrEmpty
+func (list *DoublyCircularList[T]) Back() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.head.Prev.Data, nil
+}
+
+// Print prints one lap of the list, from the head to the tail
+func (list *DoublyCircularList[T]) Print() {
+	for v := range list.Values() {
+		fmt.Println("Data is :", v)
+	}
+}
+
+// InsertAtBack inserts a new node before the head, which makes it the new tail, in O(1)
+func (list *DoublyCircularList[T]) InsertAtBack(data T) {
+	list.insertBefore(list.head, data)
+}
+
+// PushBack is the same as InsertAtBack
+func (list *DoublyCircularList[T]) PushBack(data T) {
+	list.InsertAtBack(data)
+}
+
+// InsertAtFront inserts a new node before the head and makes it the new head in O(1)
+func (list *DoublyCircularList[T]) InsertAtFront(data T) {
+	list.head = list.insertBefore(list.head, data)
+}
+
+// PushFront is the same as InsertAtFront
+func (list *DoublyCircularList[T]) PushFront(data T) {
+	list.InsertAtFront(data)
+}
+
+// PopFront removes the head in O(1) and returns its data, or ErrEmpty
+func (list *DoublyCircularList[T]) PopFront() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	node := list.head
+	list.unlink(node)
+	return node.Data, nil
+}
+
+// PopBack removes the tail in O(1) and returns its data, or ErrEmpty
+func (list *DoublyCircularList[T]) PopBack() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	node := list.head.Prev
+	list.unlink(node)
+	return node.Data, nil
+}
+
+/*
+InsertAfterValue inserts a new node after the first node holding afterValue
+If the list is empty, it returns ErrEmpty
+If no node holds afterValue, it returns ErrNotFound
+*/
+func (list *DoublyCircularList[T]) InsertAfterValue(afterValue, data T) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	node := list.find(afterValue)
+	if node == nil {
+		return ErrNotFound
+	}
+
+	list.insertBefore(node.Next, data)
+	return nil
+}
+
+/*
+InsertBeforeValue inserts a new node before the first node holding beforeValue
+If beforeValue is in the head, the new node becomes the head
+If the list is empty, it returns ErrEmpty
+If no node holds beforeValue, it returns ErrNotFound
+*/
+func (list *DoublyCircularList[T]) InsertBeforeValue(beforeValue, data T) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	node := list.find(beforeValue)
+	if node == nil {
+		return ErrNotFound
+	}
+
+	inserted := list.insertBefore(node, data)
+	if node == list.head {
+		list.head = inserted
+	}
+	return nil
+}
+
+/*
+InsertInSortedList inserts a new node in a sorted doubly circular list
+Equal values are inserted after the existing ones
+If the list has no Compare function, it returns ErrNoComparator
+*/
+func (list *DoublyCircularList[T]) InsertInSortedList(data T) error {
+	if list.Compare == nil {
+		return ErrNoComparator
+	}
+
+	if list.size == 0 || list.Compare(list.head.Data, data) > 0 {
+		list.InsertAtFront(data)
+		return nil
+	}
+
+	current := list.head.Next
+	for current != list.head && list.Compare(current.Data, data) <= 0 {
+		current = current.Next
+	}
+
+	list.insertBefore(current, data)
+	return nil
+}
+
+/*
+InsertAtSpecficPosition inserts a new node so that it ends up at the given position
+Position 0 inserts at the front and position Len() inserts at the back
+If the position is negative or greater than the length of the list, it returns ErrIndexOutOfRange
+*/
+func (list *DoublyCircularList[T]) InsertAtSpecficPosition(data T, position int) error {
+	if position < 0 || position > list.size {
+		return ErrIndexOutOfRange
+	}
+
+	switch position {
+	case 0:
+		list.InsertAtFront(data)
+	case list.size:
+		list.InsertAtBack(data)
+	default:
+		list.insertBefore(list.nodeAt(position), data)
+	}
+	return nil
+}
+
+/*
+UpdateValueByOldValue updates the first node holding oldValue to newValue
+If the list is empty, it returns ErrEmpty
+If no node holds oldValue, it returns ErrNotFound
+*/
+func (list *DoublyCircularList[T]) UpdateValueByOldValue(oldValue, newValue T) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	node := list.find(oldValue)
+	if node == nil {
+		return ErrNotFound
+	}
+
+	node.Data = newValue
+	return nil
+}
+
+/*
+UpdateAllValueByOldValue updates every node holding oldValue to newValue
+It returns the number of updated nodes, ErrEmpty if the list is empty and ErrNotFound if nothing matched
+*/
+func (list *DoublyCircularList[T]) UpdateAllValueByOldValue(oldValue, newValue T) (int, error) {
+	if list.size == 0 {
+		return 0, ErrEmpty
+	}
+
+	updated := 0
+	current := list.head
+	for i := 0; i < list.size; i++ {
+		if current.Data == oldValue {
+			current.Data = newValue
+			updated++
+		}
+		current = current.Next
+	}
+
+	if updated == 0 {
+		return 0, ErrNotFound
+	}
+	return updated, nil
+}
+
+/*
+UpdateByPosition updates the value of the node at the given position
+If the list is empty, it returns ErrEmpty
+If the position is negative or not smaller than the length of the list, it returns ErrIndexOutOfRange
+*/
+func (list *DoublyCircularList[T]) UpdateByPosition(data T, position int) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	if position < 0 || position >= list.size {
+		return ErrIndexOutOfRange
+	}
+
+	list.nodeAt(position).Data = data
+	return nil
+}
+
+/*
+DeleteByValue deletes the first node holding data
+If the list is empty, it returns ErrEmpty
+If no node holds data, it returns ErrNotFound
+*/
+func (list *DoublyCircularList[T]) DeleteByValue(data T) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	node := list.find(data)
+	if node == nil {
+		return ErrNotFound
+	}
+
+	list.unlink(node)
+	return nil
+}
+
+/*
+DeleteByIndex deletes the node at the given index
+If the list is empty, it returns ErrEmpty
+If the index is negative or not smaller than the length of the list, it returns ErrIndexOutOfRange
+*/
+func (list *DoublyCircularList[T]) DeleteByIndex(index int) error {
+	if list.size == 0 {
+		return ErrEmpty
+	}
+
+	if index < 0 || index >= list.size {
+		return ErrIndexOutOfRange
+	}
+
+	list.unlink(list.nodeAt(index))
+	return nil
+}
+
+/*
+DeleteAllByValue deletes every node holding value
+It walks exactly one lap, so it stops even though the list has no end
+It returns the number of deleted nodes, ErrEmpty if the list is empty and ErrNotFound if nothing matched
+*/
+func (list *DoublyCircularList[T]) DeleteAllByValue(value T) (int, error) {
+	if list.size == 0 {
+		return 0, ErrEmpty
+	}
+
+	deleted := 0
+	current := list.head
+	for remaining := list.size; remaining > 0; remaining-- {
+		next := current.Next
+		if current.Data == value {
+			list.unlink(current)
+			deleted++
+		}
+		current = next
+	}
+
+	if deleted == 0 {
+		return 0, ErrNotFound
+	}
+	return deleted, nil
+}
+
+// FindIndexByValue returns the position of the first node holding data, or ErrNotFound
+func (list *DoublyCircularList[T]) FindIndexByValue(data T) (int, error) {
+	current := list.head
+	for i := 0; i < list.size; i++ {
+		if current.Data == data {
+			return i, nil
+		}
+		current = current.Next
+	}
+	return -1, ErrNotFound
+}
+
+// Values returns an iterator over one lap of the list, from the head to the tail
+func (list *DoublyCircularList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		current := list.head
+		for i := 0; i < list.size; i++ {
+			if !yield(current.Data) {
+				return
+			}
+			current = current.Next
+		}
+	}
+}
+
+// Backward returns an iterator over one lap of the list, from the tail to the head
+func (list *DoublyCircularList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if list.size == 0 {
+			return
+		}
+
+		current := list.head.Prev
+		for i := 0; i < list.size; i++ {
+			if !yield(current.Data) {
+				return
+			}
+			current = current.Prev
+		}
+	}
+}
+
+// Current returns the data of the node under the cursor, or ErrEmpty
+func (list *DoublyCircularList[T]) Current() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return list.cursor.Data, nil
+}
+
+/*
+Advance moves the cursor n nodes and returns the data of the new current node
+A positive n follows the Next links and a negative n follows the Prev links
+Only n modulo the length of the list steps are taken
+If the list is empty, it returns ErrEmpty
+*/
+func (list *DoublyCircularList[T]) Advance(n int) (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	n %= list.size
+	for ; n > 0; n-- {
+		list.cursor = list.cursor.Next
+	}
+	for ; n < 0; n++ {
+		list.cursor = list.cursor.Prev
+	}
+	return list.cursor.Data, nil
+}
+
+/*
+RemoveCurrent removes the node under the cursor in O(1) and returns its data
+The cursor moves on to the next node
+If the list is empty, it returns ErrEmpty
+*/
+func (list *DoublyCircularList[T]) RemoveCurrent() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	node := list.cursor
+	list.unlink(node)
+	return node.Data, nil
+}
+
+// ResetCursor moves the cursor back to the head
+func (list *DoublyCircularList[T]) ResetCursor() {
+	list.cursor = list.head
+}
+
+/*
+Next returns the data under the cursor and then moves the cursor one node forward
+Calling it repeatedly hands out the values in round-robin order
+If the list is empty, it returns ErrEmpty
+*/
+func (list *DoublyCircularList[T]) Next() (T, error) {
+	if list.size == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+
+	node := list.cursor
+	list.cursor = node.Next
+	return node.Data, nil
+}
+
+/*
+RoundRobin returns an endless iterator that calls Next on every step
+The loop must be stopped by the caller with break, or ends by itself once the list becomes empty
+*/
+func (list *DoublyCircularList[T]) RoundRobin() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			v, err := list.Next()
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
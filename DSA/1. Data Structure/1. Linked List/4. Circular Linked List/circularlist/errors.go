@@ -0,0 +1,11 @@
+package circularlist
+
+import "errors"
+
+// Sentinel errors returned by the CircularList and DoublyCircularList operations
+var (
+	ErrNotFound        = errors.New("circularlist: no record found")
+	ErrIndexOutOfRange = errors.New("circularlist: index out of range")
+	ErrEmpty           = errors.New("circularlist: list is empty")
+	ErrNoComparator    = errors.New("circularlist: list has no compare function")
+)
@@ -0,0 +1,229 @@
+package circularlist
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"generic/diagram"
+)
+
+/*
+walkRing follows next from start and returns the nodes it visits, each one once
+loop is the index of the node the last one links back to, which is 0 for a healthy ring, or -1 if a link is nil
+*/
+func walkRing[N comparable](start N, next func(N) N, isNil func(N) bool) (nodes []N, loop int) {
+	index := make(map[N]int)
+	for current := start; !isNil(current); current = next(current) {
+		if i, seen := index[current]; seen {
+			return nodes, i
+		}
+		index[current] = len(nodes)
+		nodes = append(nodes, current)
+	}
+	return nodes, -1
+}
+
+// ringProblems lists the ways a ring of nodes disagrees with a healthy circular list of the given size
+func ringProblems(length, loop, size int) []string {
+	var problems []string
+	switch {
+	case length > 0 && loop < 0:
+		problems = append(problems, "the ring is broken: a next link is nil")
+	case loop > 0:
+		problems = append(problems, fmt.Sprintf("the last node links back to node %d instead of the head", loop))
+	}
+
+	if size != length {
+		problems = append(problems, fmt.Sprintf("size is %d but %d nodes are reachable", size, length))
+	}
+	return problems
+}
+
+// nodes returns the nodes of the list starting at the head, and where the last one links back to
+func (list *CircularList[T]) nodes() ([]*Node[T], int) {
+	if list.tail == nil {
+		return nil, -1
+	}
+	return walkRing(list.tail.Next,
+		func(node *Node[T]) *Node[T] { return node.Next },
+		func(node *Node[T]) bool { return node == nil })
+}
+
+// markers returns the Head, Tail and Cursor labels of every marked node
+func (list *CircularList[T]) markers(nodes []*Node[T]) map[int][]string {
+	markers := make(map[int][]string)
+	for i, node := range nodes {
+		if i == 0 {
+			markers[i] = append(markers[i], "Head")
+		}
+		if node == list.tail {
+			markers[i] = append(markers[i], "Tail")
+		}
+		if list.before != nil && node == list.before.Next {
+			markers[i] = append(markers[i], "Cursor")
+		}
+	}
+	return markers
+}
+
+/*
+RenderASCII draws the ring as text, with the return link from the tail back to the head drawn below it:
+
+	Head,Cursor   Tail
+	 v             v
+	[1] -> [2] -> [3] -+
+	 ^-----------------+
+
+A ring buffer shows how full it is, and a broken ring or a wrong size is listed below the drawing
+*/
+func (list *CircularList[T]) RenderASCII() string {
+	nodes, loop := list.nodes()
+
+	cells := make([]string, len(nodes))
+	arrows := make([]string, len(nodes))
+	for i, node := range nodes {
+		cells[i] = fmt.Sprintf("[%v]", node.Data)
+		arrows[i] = " -> "
+	}
+
+	drawing := diagram.Chain(cells, arrows, list.markers(nodes), " -> nil", "(empty)", loop)
+	if list.capacity > 0 {
+		drawing += fmt.Sprintf("ring %d/%d\n", list.size, list.capacity)
+	}
+	return drawing + diagram.Problems(ringProblems(len(nodes), loop, list.size))
+}
+
+// ToDOT describes the ring as a Graphviz digraph, render it with: dot -Tsvg ring.dot -o ring.svg
+func (list *CircularList[T]) ToDOT() string {
+	nodes, loop := list.nodes()
+
+	var dot strings.Builder
+	dot.WriteString("digraph CircularList {\n\tnode [shape=box];\n")
+	for i, node := range nodes {
+		fmt.Fprintf(&dot, "\tn%d [label=%q];\n", i, fmt.Sprint(node.Data))
+	}
+	for i := 1; i < len(nodes); i++ {
+		fmt.Fprintf(&dot, "\tn%d -> n%d;\n", i-1, i)
+	}
+	if loop >= 0 {
+		fmt.Fprintf(&dot, "\tn%d -> n%d;\n", len(nodes)-1, loop)
+	}
+
+	writeMarkers(&dot, list.markers(nodes))
+	for _, problem := range ringProblems(len(nodes), loop, list.size) {
+		fmt.Fprintf(&dot, "\t// %s\n", problem)
+	}
+	dot.WriteString("}\n")
+	return dot.String()
+}
+
+// nodes returns the nodes of the list starting at the head, and where the last one links back to
+func (list *DoublyCircularList[T]) nodes() ([]*DoublyNode[T], int) {
+	return walkRing(list.head,
+		func(node *DoublyNode[T]) *DoublyNode[T] { return node.Next },
+		func(node *DoublyNode[T]) bool { return node == nil })
+}
+
+// markers returns the Head, Tail and Cursor labels of every marked node
+func (list *DoublyCircularList[T]) markers(nodes []*DoublyNode[T]) map[int][]string {
+	markers := make(map[int][]string)
+	for i, node := range nodes {
+		if i == 0 {
+			markers[i] = append(markers[i], "Head")
+		}
+		if node == list.head.Prev {
+			markers[i] = append(markers[i], "Tail")
+		}
+		if node == list.cursor {
+			markers[i] = append(markers[i], "Cursor")
+		}
+	}
+	return markers
+}
+
+// linked reports whether the Prev link of nodes[i] points to the node before it in the ring
+func linked[T comparable](nodes []*DoublyNode[T], i int) bool {
+	before := nodes[(i+len(nodes)-1)%len(nodes)]
+	return nodes[i].Prev == before
+}
+
+// problems lists the ring problems plus every Prev link that does not point back
+func (list *DoublyCircularList[T]) problems(nodes []*DoublyNode[T], loop int) []string {
+	problems := ringProblems(len(nodes), loop, list.size)
+	for i := range nodes {
+		if !linked(nodes, i) {
+			problems = append(problems, fmt.Sprintf("the Prev link of node %d does not point to the node before it", i))
+		}
+	}
+	return problems
+}
+
+/*
+RenderASCII draws the ring as text like CircularList.RenderASCII, with " <-> " between nodes that link both ways
+A Prev link that does not point back is drawn as a one way " --> " and listed below the drawing
+*/
+func (list *DoublyCircularList[T]) RenderASCII() string {
+	nodes, loop := list.nodes()
+
+	cells := make([]string, len(nodes))
+	arrows := make([]string, len(nodes))
+	for i, node := range nodes {
+		cells[i] = fmt.Sprintf("[%v]", node.Data)
+		arrows[i] = " --> "
+		if linked(nodes, i) {
+			arrows[i] = " <-> "
+		}
+	}
+
+	drawing := diagram.Chain(cells, arrows, list.markers(nodes), " -> nil", "(empty)", loop)
+	return drawing + diagram.Problems(list.problems(nodes, loop))
+}
+
+/*
+ToDOT describes the ring as a Graphviz digraph, render it with: dot -Tsvg ring.dot -o ring.svg
+Next links are solid edges and Prev links dashed edges, a Prev link that does not point back is drawn in red
+*/
+func (list *DoublyCircularList[T]) ToDOT() string {
+	nodes, loop := list.nodes()
+	index := make(map[*DoublyNode[T]]int)
+	for i, node := range nodes {
+		index[node] = i
+	}
+
+	var dot strings.Builder
+	dot.WriteString("digraph DoublyCircularList {\n\tnode [shape=box];\n")
+	for i, node := range nodes {
+		fmt.Fprintf(&dot, "\tn%d [label=%q];\n", i, fmt.Sprint(node.Data))
+	}
+	for i, node := range nodes {
+		if next, ok := index[node.Next]; ok {
+			fmt.Fprintf(&dot, "\tn%d -> n%d;\n", i, next)
+		}
+
+		style := "style=dashed"
+		if !linked(nodes, i) {
+			style += ", color=red"
+		}
+		if prev, ok := index[node.Prev]; ok {
+			fmt.Fprintf(&dot, "\tn%d -> n%d [%s];\n", i, prev, style)
+		}
+	}
+
+	writeMarkers(&dot, list.markers(nodes))
+	for _, problem := range list.problems(nodes, loop) {
+		fmt.Fprintf(&dot, "\t// %s\n", problem)
+	}
+	dot.WriteString("}\n")
+	return dot.String()
+}
+
+// writeMarkers adds a plain text node for every marker with a dashed edge to the node it marks
+func writeMarkers(dot *strings.Builder, markers map[int][]string) {
+	for _, i := range slices.Sorted(maps.Keys(markers)) {
+		for _, name := range markers[i] {
+			fmt.Fprintf(dot, "\t%s [shape=plaintext];\n\t%s -> n%d [style=dashed];\n", name, name, i)
+		}
+	}
+}
@@ -0,0 +1,7 @@
+module circular
+
+go 1.23.4
+
+require generic v0.0.0
+
+replace generic => "../1. Single Linked List/1. With Generic"
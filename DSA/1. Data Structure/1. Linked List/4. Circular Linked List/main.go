@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"circular/circularlist"
+)
+
+/*
+josephus returns the order in which n people standing in a circle are eliminated when every kth person is removed
+It is the classic use of a circular list: advance k-1 places, remove the current person, repeat
+*/
+func josephus(n, k int) []int {
+	circle := circularlist.New[int]()
+	for i := 1; i <= n; i++ {
+		circle.InsertAtBack(i)
+	}
+
+	order := make([]int, 0, n)
+	for circle.Len() > 0 {
+		circle.Advance(k - 1)
+		person, _ := circle.RemoveCurrent()
+		order = append(order, person)
+	}
+	return order
+}
+
+func main() {
+	list := circularlist.New[int]()
+	list.InsertAtBack(2)
+	list.InsertAtBack(4)
+	list.InsertAtFront(1)
+	list.InsertAfterValue(2, 3)
+	list.InsertInSortedList(5)
+	list.UpdateByPosition(6, 4)
+	if err := list.DeleteByIndex(7); err != nil {
+		fmt.Println("DeleteByIndex :", err)
+	}
+	list.Print()
+
+	fmt.Println("Josephus (7, 3) :", josephus(7, 3))
+
+	// Round-robin scheduling over a doubly circular list
+	tasks := circularlist.NewDoubly[string]()
+	tasks.PushBack("download")
+	tasks.PushBack("resize")
+	tasks.PushBack("upload")
+	turns := 0
+	for task := range tasks.RoundRobin() {
+		fmt.Println("Running :", task)
+		turns++
+		if turns == 5 {
+			break
+		}
+	}
+	if current, err := tasks.Advance(-2); err == nil {
+		fmt.Println("Two steps back :", current)
+	}
+	fmt.Println("Backward :", slices.Collect(tasks.Backward()))
+
+	// Ring buffer keeping only the last 3 readings
+	readings := circularlist.NewRing[float64](3)
+	for _, reading := range []float64{21.5, 22.0, 22.4, 23.1, 22.8} {
+		readings.PushBack(reading)
+	}
+	fmt.Println("Last Readings :", slices.Collect(readings.Values()), "Full :", readings.IsFull())
+
+	fmt.Print(readings.RenderASCII())
+	fmt.Print(tasks.RenderASCII())
+	fmt.Print(tasks.ToDOT())
+}
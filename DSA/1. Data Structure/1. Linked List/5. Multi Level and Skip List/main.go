@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"multilevel/multilevel"
+	"multilevel/skiplist"
+)
+
+/*
+buildChapters builds the multi-level list
+
+	1 -> 2 -> 3
+	|         |
+	4 -> 5    6
+	|
+	7
+*/
+func buildChapters() *multilevel.MultiLevelList[int] {
+	list := &multilevel.MultiLevelList[int]{}
+	one := list.InsertAtBack(1)
+	list.InsertAtBack(2)
+	three := list.InsertAtBack(3)
+	four := list.AddChild(one, 4)
+	list.AddChild(one, 5)
+	list.AddChild(four, 7)
+	list.AddChild(three, 6)
+	return list
+}
+
+func main() {
+	depthFirst := buildChapters()
+	depthFirst.FlattenDepthFirst()
+	fmt.Println("Depth First :")
+	depthFirst.Print()
+
+	breadthFirst := buildChapters()
+	fmt.Println("Breadth First Iterator :", slices.Collect(breadthFirst.BreadthFirst()))
+	breadthFirst.FlattenBreadthFirst()
+	fmt.Println("Breadth First :")
+	breadthFirst.Print()
+
+	scores := skiplist.New[string, int](skiplist.Config{})
+	scores.Insert("carol", 72)
+	scores.Insert("alice", 90)
+	scores.Insert("dave", 64)
+	scores.Insert("bob", 85)
+	scores.Delete("dave")
+	if score, ok := scores.Search("bob"); ok {
+		fmt.Println("bob :", score)
+	}
+	if rank, ok := scores.Rank("carol"); ok {
+		fmt.Println("carol rank :", rank)
+	}
+	if name, score, ok := scores.Select(0); ok {
+		fmt.Println("first :", name, score)
+	}
+	for name, score := range scores.Range("b", "d") {
+		fmt.Println("range :", name, score)
+	}
+
+}
@@ -0,0 +1,165 @@
+/*
+Package multilevel provides a multi-level linked list
+Every node has a Next pointer to the following node on its own level and an optional Child pointer
+to the head of a whole list one level below, which makes the structure a tree of linked lists
+Flattening turns it back into a single level list, either depth-first or breadth-first
+*/
+package multilevel
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Node represents a single node of the multi-level list
+type Node[T any] struct {
+	Data  T
+	Next  *Node[T]
+	Child *Node[T]
+}
+
+// MultiLevelList represents a multi-level linked list, Head is the first node of the top level
+type MultiLevelList[T any] struct {
+	Head *Node[T]
+}
+
+// InsertAtBack appends a new node to the top level and returns it, so children can be attached to it
+func (list *MultiLevelList[T]) InsertAtBack(data T) *Node[T] {
+	node := &Node[T]{Data: data}
+	if list.Head == nil {
+		list.Head = node
+		return node
+	}
+
+	lastNode(list.Head).Next = node
+	return node
+}
+
+// AddChild appends a new node to the child list of parent and returns it
+func (list *MultiLevelList[T]) AddChild(parent *Node[T], data T) *Node[T] {
+	node := &Node[T]{Data: data}
+	if parent.Child == nil {
+		parent.Child = node
+		return node
+	}
+
+	lastNode(parent.Child).Next = node
+	return node
+}
+
+/*
+FlattenDepthFirst flattens the list in place so that every child list comes right after its parent
+It walks the top level, and whenever a node has a Child it splices the child list between the node and its Next
+The spliced nodes are walked later by the same loop, so grandchildren are handled without recursion
+The result is the pre-order (depth-first) order of the nodes, and every Child pointer is cleared
+*/
+func (list *MultiLevelList[T]) FlattenDepthFirst() {
+	for current := list.Head; current != nil; current = current.Next {
+		if current.Child == nil {
+			continue
+		}
+
+		childTail := lastNode(current.Child)
+		childTail.Next = current.Next
+		current.Next = current.Child
+		current.Child = nil
+	}
+}
+
+/*
+FlattenBreadthFirst flattens the list in place level by level
+It keeps a pointer to the end of the flattened list, and whenever a node has a Child it appends the whole
+child list at that end, so every level is placed after the level above it
+The result is the level-order (breadth-first) order of the nodes, and every Child pointer is cleared
+*/
+func (list *MultiLevelList[T]) FlattenBreadthFirst() {
+	if list.Head == nil {
+		return
+	}
+
+	tail := lastNode(list.Head)
+	for current := list.Head; current != nil; current = current.Next {
+		if current.Child == nil {
+			continue
+		}
+
+		tail.Next = current.Child
+		tail = lastNode(tail)
+		current.Child = nil
+	}
+}
+
+/*
+DepthFirst returns an iterator over the values in depth-first order without changing the list
+It keeps the pending Next nodes on an explicit stack instead of recursing
+*/
+func (list *MultiLevelList[T]) DepthFirst() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		stack := []*Node[T]{}
+		current := list.Head
+		for current != nil || len(stack) > 0 {
+			if current == nil {
+				current = stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+			}
+
+			if !yield(current.Data) {
+				return
+			}
+
+			if current.Child != nil {
+				if current.Next != nil {
+					stack = append(stack, current.Next)
+				}
+				current = current.Child
+				continue
+			}
+			current = current.Next
+		}
+	}
+}
+
+/*
+BreadthFirst returns an iterator over the values in breadth-first order without changing the list
+It keeps a queue with the heads of the child lists that still have to be visited
+*/
+func (list *MultiLevelList[T]) BreadthFirst() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		queue := []*Node[T]{}
+		if list.Head != nil {
+			queue = append(queue, list.Head)
+		}
+
+		for len(queue) > 0 {
+			head := queue[0]
+			queue = queue[1:]
+			for current := head; current != nil; current = current.Next {
+				if !yield(current.Data) {
+					return
+				}
+				if current.Child != nil {
+					queue = append(queue, current.Child)
+				}
+			}
+		}
+	}
+}
+
+// Print prints the top level of the list, nodes with children are marked with a "+"
+func (list *MultiLevelList[T]) Print() {
+	for current := list.Head; current != nil; current = current.Next {
+		if current.Child != nil {
+			fmt.Println("Data is :", current.Data, "+")
+			continue
+		}
+		fmt.Println("Data is :", current.Data)
+	}
+}
+
+// lastNode returns the last node of the level that starts at node
+func lastNode[T any](node *Node[T]) *Node[T] {
+	for node.Next != nil {
+		node = node.Next
+	}
+	return node
+}
@@ -0,0 +1,113 @@
+package multilevel_test
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"multilevel/multilevel"
+)
+
+/*
+chapters builds the list of main.go
+
+	1 -> 2 -> 3
+	|         |
+	4 -> 5    6
+	|
+	7
+*/
+func chapters() *multilevel.MultiLevelList[int] {
+	list := &multilevel.MultiLevelList[int]{}
+	one := list.InsertAtBack(1)
+	list.InsertAtBack(2)
+	three := list.InsertAtBack(3)
+	four := list.AddChild(one, 4)
+	list.AddChild(one, 5)
+	list.AddChild(four, 7)
+	list.AddChild(three, 6)
+	return list
+}
+
+var (
+	depthFirst   = []int{1, 4, 7, 5, 2, 3, 6}
+	breadthFirst = []int{1, 2, 3, 4, 5, 6, 7}
+)
+
+// flat returns the values of the top level and fails t if a node still has a Child
+func flat(t *testing.T, list *multilevel.MultiLevelList[int]) []int {
+	t.Helper()
+
+	var values []int
+	for node := list.Head; node != nil; node = node.Next {
+		if node.Child != nil {
+			t.Fatalf("node %d still has a child after flattening", node.Data)
+		}
+		values = append(values, node.Data)
+	}
+	return values
+}
+
+func TestFlatten(t *testing.T) {
+	list := chapters()
+	list.FlattenDepthFirst()
+	if got := flat(t, list); !slices.Equal(got, depthFirst) {
+		t.Fatalf("FlattenDepthFirst: got %v, want %v", got, depthFirst)
+	}
+
+	list = chapters()
+	list.FlattenBreadthFirst()
+	if got := flat(t, list); !slices.Equal(got, breadthFirst) {
+		t.Fatalf("FlattenBreadthFirst: got %v, want %v", got, breadthFirst)
+	}
+
+	// Flattening a flat or an empty list changes nothing
+	list.FlattenDepthFirst()
+	if got := flat(t, list); !slices.Equal(got, breadthFirst) {
+		t.Fatalf("flattening again: got %v, want %v", got, breadthFirst)
+	}
+	empty := &multilevel.MultiLevelList[int]{}
+	empty.FlattenDepthFirst()
+	empty.FlattenBreadthFirst()
+	if empty.Head != nil {
+		t.Fatal("flattening an empty list added a node")
+	}
+}
+
+// TestIterators checks that the iterators give the flattened orders without changing the list, and stop on break
+func TestIterators(t *testing.T) {
+	list := chapters()
+	if got := slices.Collect(list.DepthFirst()); !slices.Equal(got, depthFirst) {
+		t.Fatalf("DepthFirst: got %v, want %v", got, depthFirst)
+	}
+	if got := slices.Collect(list.BreadthFirst()); !slices.Equal(got, breadthFirst) {
+		t.Fatalf("BreadthFirst: got %v, want %v", got, breadthFirst)
+	}
+	if list.Head.Child == nil || list.Head.Next.Data != 2 {
+		t.Fatal("the iterators changed the list")
+	}
+
+	if got := collect(list.DepthFirst(), 3); !slices.Equal(got, depthFirst[:3]) {
+		t.Fatalf("DepthFirst: got %v after a break, want %v", got, depthFirst[:3])
+	}
+	if got := collect(list.BreadthFirst(), 3); !slices.Equal(got, breadthFirst[:3]) {
+		t.Fatalf("BreadthFirst: got %v after a break, want %v", got, breadthFirst[:3])
+	}
+
+	empty := &multilevel.MultiLevelList[int]{}
+	if slices.Collect(empty.DepthFirst()) != nil || slices.Collect(empty.BreadthFirst()) != nil {
+		t.Fatal("an empty list yielded values")
+	}
+}
+
+// collect takes at most n values of seq and breaks out of the loop after the nth
+func collect(seq iter.Seq[int], n int) []int {
+	var values []int
+	for v := range seq {
+		values = append(values, v)
+		if len(values) == n {
+			break
+		}
+	}
+	return values
+}
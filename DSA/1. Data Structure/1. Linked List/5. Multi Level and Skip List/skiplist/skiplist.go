@@ -0,0 +1,276 @@
+/*
+Package skiplist provides a probabilistic ordered map
+A skip list is a stack of sorted linked lists: the bottom level holds every key and each higher level
+holds a random subset of the level below it, so a search can skip over long runs of nodes
+Search, Insert and Delete take O(log n) expected time, compared to O(n) for a sorted linked list
+Every link also stores its span (how many bottom level nodes it jumps over), which makes rank queries O(log n) too
+*/
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"math/rand/v2"
+)
+
+const (
+	// DefaultMaxLevel is used when Config.MaxLevel is 0, it is enough for about 4^32 keys with the default P
+	DefaultMaxLevel = 32
+	// DefaultP is used when Config.P is 0
+	DefaultP = 0.25
+)
+
+/*
+Config holds the tuning knobs of a SkipList
+MaxLevel caps the number of levels a node can reach
+P is the probability that a node on one level is also promoted to the next level
+A smaller P uses less memory per node but makes searches take a few more steps
+*/
+type Config struct {
+	MaxLevel int
+	P        float64
+}
+
+// node is a single key/value pair with one forward link and one span per level
+type node[K, V any] struct {
+	key   K
+	value V
+	next  []*node[K, V]
+	span  []int
+}
+
+// SkipList represents an ordered map from K to V
+type SkipList[K, V any] struct {
+	head     *node[K, V]
+	level    int
+	size     int
+	maxLevel int
+	p        float64
+	compare  func(a, b K) int
+}
+
+// New returns an empty skip list of an ordered key type that uses cmp.Compare
+func New[K cmp.Ordered, V any](config Config) *SkipList[K, V] {
+	return NewFunc[K, V](cmp.Compare[K], config)
+}
+
+// NewFunc returns an empty skip list that orders its keys with the given compare function
+func NewFunc[K, V any](compare func(a, b K) int, config Config) *SkipList[K, V] {
+	if config.MaxLevel <= 0 {
+		config.MaxLevel = DefaultMaxLevel
+	}
+	if config.P <= 0 || config.P >= 1 {
+		config.P = DefaultP
+	}
+
+	return &SkipList[K, V]{
+		head:     &node[K, V]{next: make([]*node[K, V], config.MaxLevel), span: make([]int, config.MaxLevel)},
+		level:    1,
+		maxLevel: config.MaxLevel,
+		p:        config.P,
+		compare:  compare,
+	}
+}
+
+// randomLevel flips a biased coin until it fails, every success adds a level
+func (list *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < list.maxLevel && rand.Float64() < list.p {
+		level++
+	}
+	return level
+}
+
+// Len returns the number of keys
+func (list *SkipList[K, V]) Len() int {
+	return list.size
+}
+
+/*
+Search returns the value stored for key
+On every level it moves right while the next key is smaller, then drops one level down
+*/
+func (list *SkipList[K, V]) Search(key K) (V, bool) {
+	current := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && list.compare(current.next[i].key, key) < 0 {
+			current = current.next[i]
+		}
+	}
+
+	current = current.next[0]
+	if current != nil && list.compare(current.key, key) == 0 {
+		return current.value, true
+	}
+
+	var zero V
+	return zero, false
+}
+
+/*
+Insert stores value for key and reports whether the key is new
+It records the last node visited on every level (update) and the rank of that node,
+then links a node of random height after those nodes and fixes the spans around it
+*/
+func (list *SkipList[K, V]) Insert(key K, value V) bool {
+	update := make([]*node[K, V], list.maxLevel)
+	rank := make([]int, list.maxLevel)
+
+	current := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		if i < list.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for current.next[i] != nil && list.compare(current.next[i].key, key) < 0 {
+			rank[i] += current.span[i]
+			current = current.next[i]
+		}
+		update[i] = current
+	}
+
+	if next := current.next[0]; next != nil && list.compare(next.key, key) == 0 {
+		next.value = value
+		return false
+	}
+
+	level := list.randomLevel()
+	if level > list.level {
+		for i := list.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = list.head
+			update[i].span[i] = list.size
+		}
+		list.level = level
+	}
+
+	inserted := &node[K, V]{key: key, value: value, next: make([]*node[K, V], level), span: make([]int, level)}
+	for i := 0; i < level; i++ {
+		inserted.next[i] = update[i].next[i]
+		update[i].next[i] = inserted
+
+		inserted.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+
+	for i := level; i < list.level; i++ {
+		update[i].span[i]++
+	}
+
+	list.size++
+	return true
+}
+
+// Delete removes key and reports whether it was present
+func (list *SkipList[K, V]) Delete(key K) bool {
+	update := make([]*node[K, V], list.maxLevel)
+
+	current := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && list.compare(current.next[i].key, key) < 0 {
+			current = current.next[i]
+		}
+		update[i] = current
+	}
+
+	target := current.next[0]
+	if target == nil || list.compare(target.key, key) != 0 {
+		return false
+	}
+
+	for i := 0; i < list.level; i++ {
+		if update[i].next[i] == target {
+			update[i].span[i] += target.span[i] - 1
+			update[i].next[i] = target.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+
+	for list.level > 1 && list.head.next[list.level-1] == nil {
+		list.level--
+	}
+
+	list.size--
+	return true
+}
+
+/*
+Rank returns the 0 based position of key in sorted order
+It adds up the spans of the links it follows while searching for the key
+*/
+func (list *SkipList[K, V]) Rank(key K) (int, bool) {
+	rank := 0
+	current := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && list.compare(current.next[i].key, key) <= 0 {
+			rank += current.span[i]
+			current = current.next[i]
+		}
+
+		if current != list.head && list.compare(current.key, key) == 0 {
+			return rank - 1, true
+		}
+	}
+
+	return 0, false
+}
+
+/*
+Select returns the key and value at the given 0 based position in sorted order
+It follows a link only if its span does not overshoot the wanted position
+*/
+func (list *SkipList[K, V]) Select(index int) (K, V, bool) {
+	if index < 0 || index >= list.size {
+		var key K
+		var value V
+		return key, value, false
+	}
+
+	target := index + 1
+	traversed := 0
+	current := list.head
+	for i := list.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && traversed+current.span[i] <= target {
+			traversed += current.span[i]
+			current = current.next[i]
+		}
+
+		if traversed == target {
+			break
+		}
+	}
+
+	return current.key, current.value, true
+}
+
+/*
+Range returns an iterator over the keys k with from <= k < to, in ascending order
+It searches for from in O(log n) and then walks the bottom level
+*/
+func (list *SkipList[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		current := list.head
+		for i := list.level - 1; i >= 0; i-- {
+			for current.next[i] != nil && list.compare(current.next[i].key, from) < 0 {
+				current = current.next[i]
+			}
+		}
+
+		for current = current.next[0]; current != nil && list.compare(current.key, to) < 0; current = current.next[0] {
+			if !yield(current.key, current.value) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over every key and value in ascending order
+func (list *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for current := list.head.next[0]; current != nil; current = current.next[0] {
+			if !yield(current.key, current.value) {
+				return
+			}
+		}
+	}
+}
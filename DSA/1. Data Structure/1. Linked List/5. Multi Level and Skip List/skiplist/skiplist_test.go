@@ -0,0 +1,182 @@
+package skiplist_test
+
+import (
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"generic/linkedlist"
+	"multilevel/skiplist"
+)
+
+// sizes are the numbers of keys the benchmarks insert, the sorted linked list slows down quadratically with them
+var sizes = []int{1_000, 10_000}
+
+// keys returns 0 to n-1 in a fixed random order
+func keys(n int) []int {
+	return rand.New(rand.NewPCG(1, 2)).Perm(n)
+}
+
+// check fails t unless list holds exactly the keys and values of want, in order and with the right ranks
+func check(t *testing.T, list *skiplist.SkipList[int, int], want map[int]int) {
+	t.Helper()
+
+	sorted := slices.Sorted(maps.Keys(want))
+	var got []int
+	for key := range list.All() {
+		got = append(got, key)
+	}
+	if !slices.Equal(got, sorted) || list.Len() != len(want) {
+		t.Fatalf("got keys %v with Len %d, want %v", got, list.Len(), sorted)
+	}
+
+	for i, key := range sorted {
+		if value, ok := list.Search(key); !ok || value != want[key] {
+			t.Fatalf("Search(%d) = %d, %t, want %d", key, value, ok, want[key])
+		}
+		if rank, ok := list.Rank(key); !ok || rank != i {
+			t.Fatalf("Rank(%d) = %d, %t, want %d", key, rank, ok, i)
+		}
+		if key, value, ok := list.Select(i); !ok || key != sorted[i] || value != want[key] {
+			t.Fatalf("Select(%d) = %d, %d, %t, want %d", i, key, value, ok, sorted[i])
+		}
+	}
+	if _, _, ok := list.Select(-1); ok {
+		t.Fatal("Select(-1) found a key")
+	}
+	if _, _, ok := list.Select(len(sorted)); ok {
+		t.Fatalf("Select(%d) found a key past the end", len(sorted))
+	}
+}
+
+/*
+TestModel runs random inserts and deletes against a map, with many seeds and with both a tall and a capped list
+After every step the ranks of every key must match the sorted keys of the map, which checks every span
+*/
+func TestModel(t *testing.T) {
+	configs := map[string]skiplist.Config{
+		"Default": {},
+		"Capped":  {MaxLevel: 3, P: 0.5},
+	}
+	for name, config := range configs {
+		for seed := range uint64(50) {
+			random := rand.New(rand.NewPCG(seed, 1))
+			list := skiplist.New[int, int](config)
+			want := map[int]int{}
+
+			for step := range 400 {
+				key, value := random.IntN(100), random.Int()
+				if random.IntN(3) > 0 {
+					_, found := want[key]
+					if isNew := list.Insert(key, value); isNew == found {
+						t.Fatalf("%s seed %d step %d: Insert(%d) reported new %t", name, seed, step, key, isNew)
+					}
+					want[key] = value
+				} else {
+					_, found := want[key]
+					if deleted := list.Delete(key); deleted != found {
+						t.Fatalf("%s seed %d step %d: Delete(%d) = %t, want %t", name, seed, step, key, deleted, found)
+					}
+					delete(want, key)
+				}
+
+				if _, found := want[key]; !found {
+					if _, ok := list.Rank(key); ok {
+						t.Fatalf("%s seed %d step %d: Rank(%d) found a missing key", name, seed, step, key)
+					}
+					if _, ok := list.Search(key); ok {
+						t.Fatalf("%s seed %d step %d: Search(%d) found a missing key", name, seed, step, key)
+					}
+				}
+				check(t, list, want)
+			}
+
+			from, to := random.IntN(100), random.IntN(100)
+			var got []int
+			for key := range list.Range(from, to) {
+				got = append(got, key)
+			}
+			var inRange []int
+			for _, key := range slices.Sorted(maps.Keys(want)) {
+				if from <= key && key < to {
+					inRange = append(inRange, key)
+				}
+			}
+			if !slices.Equal(got, inRange) {
+				t.Fatalf("%s seed %d: Range(%d, %d) = %v, want %v", name, seed, from, to, got, inRange)
+			}
+		}
+	}
+}
+
+// BenchmarkInsert inserts n keys in random order, the skip list in O(log n) each and the sorted linked list in O(n)
+func BenchmarkInsert(b *testing.B) {
+	for _, n := range sizes {
+		keys := keys(n)
+
+		b.Run(fmt.Sprintf("SkipList/n=%d", n), func(b *testing.B) {
+			for range b.N {
+				list := skiplist.New[int, struct{}](skiplist.Config{})
+				for _, key := range keys {
+					list.Insert(key, struct{}{})
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("InsertInSortedList/n=%d", n), func(b *testing.B) {
+			for range b.N {
+				list := linkedlist.New[int]()
+				for _, key := range keys {
+					list.InsertInSortedList(key)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkSearch looks up every key of a list of n keys, the linked list has to walk up to the key each time
+func BenchmarkSearch(b *testing.B) {
+	for _, n := range sizes {
+		keys := keys(n)
+		skip := skiplist.New[int, struct{}](skiplist.Config{})
+		sorted := linkedlist.New[int]()
+		for _, key := range keys {
+			skip.Insert(key, struct{}{})
+			sorted.InsertInSortedList(key)
+		}
+
+		b.Run(fmt.Sprintf("SkipList/n=%d", n), func(b *testing.B) {
+			for range b.N {
+				for _, key := range keys {
+					skip.Search(key)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("FindIndexByValue/n=%d", n), func(b *testing.B) {
+			for range b.N {
+				for _, key := range keys {
+					sorted.FindIndexByValue(key)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkLevels inserts the same keys with different promotion probabilities, a smaller P means fewer links per node
+func BenchmarkLevels(b *testing.B) {
+	keys := keys(10_000)
+	for _, p := range []float64{0.5, 0.25, 0.125} {
+		b.Run(fmt.Sprintf("P=%v", p), func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				list := skiplist.New[int, struct{}](skiplist.Config{P: p})
+				for _, key := range keys {
+					list.Insert(key, struct{}{})
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package history
+
+import "errors"
+
+// Sentinel errors returned by History
+var (
+	ErrNothingToUndo     = errors.New("history: nothing to undo")
+	ErrNothingToRedo     = errors.New("history: nothing to redo")
+	ErrUnknownCheckpoint = errors.New("history: unknown checkpoint")
+)
@@ -0,0 +1,220 @@
+/*
+Package history provides a generic undo/redo engine built on the doubly linked list
+Every action is a Command that knows how to apply itself to a state and how to revert itself
+The commands are kept in a doubly linked list with a pointer to the last applied one:
+Undo reverts that command and steps back along Prev, Redo re-applies the next one and steps forward along Next
+*/
+package history
+
+import (
+	"double/doublylinkedlist"
+)
+
+// Command is a reversible action on a state of type S
+type Command[S any] interface {
+	Do(state S) error
+	Undo(state S) error
+}
+
+// record wraps a command so it can be stored in the doubly linked list
+type record[S any] struct {
+	command Command[S]
+}
+
+/*
+History records the commands applied to a state
+current points to the last applied command, nil means every recorded command is undone
+Doing a new command after some Undo calls drops the undone commands (the redo branch)
+With a max depth greater than 0 the oldest commands are forgotten once the history grows beyond it
+*/
+type History[S any] struct {
+	state       S
+	commands    doublylinkedlist.DoublyLinkedList[*record[S]]
+	current     *doublylinkedlist.Node[*record[S]]
+	maxDepth    int
+	checkpoints map[string]*doublylinkedlist.Node[*record[S]]
+}
+
+// New returns an empty history for state, maxDepth 0 means the history is unbounded
+func New[S any](state S, maxDepth int) *History[S] {
+	return &History[S]{
+		state:       state,
+		maxDepth:    maxDepth,
+		checkpoints: make(map[string]*doublylinkedlist.Node[*record[S]]),
+	}
+}
+
+// State returns the state the commands are applied to
+func (history *History[S]) State() S {
+	return history.state
+}
+
+// Len returns the number of recorded commands, both applied and undone
+func (history *History[S]) Len() int {
+	return history.commands.Len()
+}
+
+// CanUndo reports whether there is an applied command to undo
+func (history *History[S]) CanUndo() bool {
+	return history.current != nil
+}
+
+// CanRedo reports whether there is an undone command to redo
+func (history *History[S]) CanRedo() bool {
+	return history.next() != nil
+}
+
+/*
+Do applies command to the state and records it as the last applied command
+If the command fails nothing is recorded and its error is returned
+Any undone commands after the current position are pruned first, a new action starts a new branch
+If the history is deeper than its max depth afterwards, the oldest commands are evicted
+*/
+func (history *History[S]) Do(command Command[S]) error {
+	if err := command.Do(history.state); err != nil {
+		return err
+	}
+
+	history.pruneRedo()
+
+	history.current = history.commands.PushBack(&record[S]{command: command})
+	for history.maxDepth > 0 && history.commands.Len() > history.maxDepth {
+		history.evictOldest()
+	}
+	return nil
+}
+
+/*
+Undo reverts the last applied command and moves the current position one step back
+If there is nothing to undo, it returns ErrNothingToUndo
+If the command fails to revert, the position does not move and its error is returned
+*/
+func (history *History[S]) Undo() error {
+	if history.current == nil {
+		return ErrNothingToUndo
+	}
+
+	if err := history.current.Data.command.Undo(history.state); err != nil {
+		return err
+	}
+
+	history.current = history.current.Prev()
+	return nil
+}
+
+/*
+Redo re-applies the first undone command and moves the current position one step forward
+If there is nothing to redo, it returns ErrNothingToRedo
+If the command fails, the position does not move and its error is returned
+*/
+func (history *History[S]) Redo() error {
+	next := history.next()
+	if next == nil {
+		return ErrNothingToRedo
+	}
+
+	if err := next.Data.command.Do(history.state); err != nil {
+		return err
+	}
+
+	history.current = next
+	return nil
+}
+
+/*
+Checkpoint remembers the current position under name, replacing an older checkpoint with the same name
+The checkpoint is forgotten when the commands it points to are pruned or evicted
+*/
+func (history *History[S]) Checkpoint(name string) {
+	history.checkpoints[name] = history.current
+}
+
+/*
+RestoreCheckpoint undoes or redoes commands until the current position is the named checkpoint
+If the checkpoint does not exist (anymore), it returns ErrUnknownCheckpoint
+*/
+func (history *History[S]) RestoreCheckpoint(name string) error {
+	target, ok := history.checkpoints[name]
+	if !ok {
+		return ErrUnknownCheckpoint
+	}
+
+	if history.isBehind(target) {
+		for history.current != target {
+			if err := history.Undo(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for history.current != target {
+		if err := history.Redo(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// next returns the first undone command, or nil
+func (history *History[S]) next() *doublylinkedlist.Node[*record[S]] {
+	if history.current == nil {
+		return history.commands.FrontNode()
+	}
+	return history.current.Next()
+}
+
+// isBehind reports whether target is at or before the current position
+func (history *History[S]) isBehind(target *doublylinkedlist.Node[*record[S]]) bool {
+	if target == nil {
+		return true
+	}
+
+	for node := history.current; node != nil; node = node.Prev() {
+		if node == target {
+			return true
+		}
+	}
+	return false
+}
+
+// pruneRedo removes every undone command and the checkpoints that pointed to them
+func (history *History[S]) pruneRedo() {
+	for node := history.next(); node != nil; {
+		next := node.Next()
+		history.forget(node)
+		history.commands.Remove(node)
+		node = next
+	}
+}
+
+/*
+evictOldest forgets the oldest command
+Its effect stays in the state, so the state right after it becomes the new starting point:
+checkpoints on the starting point are dropped and checkpoints on the evicted command move to the starting point
+*/
+func (history *History[S]) evictOldest() {
+	oldest := history.commands.FrontNode()
+	for name, node := range history.checkpoints {
+		switch node {
+		case nil:
+			delete(history.checkpoints, name)
+		case oldest:
+			history.checkpoints[name] = nil
+		}
+	}
+
+	if history.current == oldest {
+		history.current = nil
+	}
+	history.commands.Remove(oldest)
+}
+
+// forget drops every checkpoint that points to node
+func (history *History[S]) forget(node *doublylinkedlist.Node[*record[S]]) {
+	for name, checkpoint := range history.checkpoints {
+		if checkpoint == node {
+			delete(history.checkpoints, name)
+		}
+	}
+}
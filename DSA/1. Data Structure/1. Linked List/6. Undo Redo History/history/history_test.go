@@ -0,0 +1,230 @@
+package history_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"undoredo/history"
+)
+
+var errRefused = errors.New("refused")
+
+// push appends Value to the state, Undo removes it again, Fail makes both refuse with errRefused
+type push struct {
+	Value int
+	Fail  bool
+}
+
+func (command *push) Do(state *[]int) error {
+	if command.Fail {
+		return errRefused
+	}
+	*state = append(*state, command.Value)
+	return nil
+}
+
+func (command *push) Undo(state *[]int) error {
+	if command.Fail {
+		return errRefused
+	}
+	*state = (*state)[:len(*state)-1]
+	return nil
+}
+
+// check fails t unless the state holds want and the history can undo and redo as given
+func check(t *testing.T, h *history.History[*[]int], want []int, canUndo, canRedo bool) {
+	t.Helper()
+
+	if got := *h.State(); !slices.Equal(got, want) {
+		t.Fatalf("got state %v, want %v", got, want)
+	}
+	if h.CanUndo() != canUndo || h.CanRedo() != canRedo {
+		t.Fatalf("state %v: CanUndo %t, CanRedo %t, want %t and %t", want, h.CanUndo(), h.CanRedo(), canUndo, canRedo)
+	}
+}
+
+func TestDoUndoRedo(t *testing.T) {
+	h := history.New(&[]int{}, 0)
+	check(t, h, nil, false, false)
+	if err := h.Undo(); !errors.Is(err, history.ErrNothingToUndo) {
+		t.Fatalf("Undo of an empty history returned %v", err)
+	}
+	if err := h.Redo(); !errors.Is(err, history.ErrNothingToRedo) {
+		t.Fatalf("Redo of an empty history returned %v", err)
+	}
+
+	for i := 1; i <= 3; i++ {
+		h.Do(&push{Value: i})
+	}
+	check(t, h, []int{1, 2, 3}, true, false)
+
+	h.Undo()
+	h.Undo()
+	check(t, h, []int{1}, true, true)
+	h.Undo()
+	check(t, h, []int{}, false, true)
+	if err := h.Undo(); !errors.Is(err, history.ErrNothingToUndo) {
+		t.Fatalf("Undo past the first command returned %v", err)
+	}
+
+	h.Redo()
+	h.Redo()
+	check(t, h, []int{1, 2}, true, true)
+	h.Redo()
+	check(t, h, []int{1, 2, 3}, true, false)
+	if err := h.Redo(); !errors.Is(err, history.ErrNothingToRedo) {
+		t.Fatalf("Redo past the last command returned %v", err)
+	}
+	if h.Len() != 3 {
+		t.Fatalf("Len is %d, want 3", h.Len())
+	}
+}
+
+// TestDoPrunesRedo checks that a new command after Undo drops the undone commands for good
+func TestDoPrunesRedo(t *testing.T) {
+	h := history.New(&[]int{}, 0)
+	for i := 1; i <= 3; i++ {
+		h.Do(&push{Value: i})
+	}
+	h.Undo()
+	h.Undo()
+
+	h.Do(&push{Value: 4})
+	check(t, h, []int{1, 4}, true, false)
+	if err := h.Redo(); !errors.Is(err, history.ErrNothingToRedo) {
+		t.Fatalf("Redo after a new command returned %v", err)
+	}
+	if h.Len() != 2 {
+		t.Fatalf("Len is %d, want 2 after pruning", h.Len())
+	}
+
+	h.Undo()
+	h.Undo()
+	check(t, h, []int{}, false, true)
+	h.Redo()
+	h.Redo()
+	check(t, h, []int{1, 4}, true, false)
+}
+
+// TestFailingCommand checks that a refused Do records nothing and a refused Undo or Redo does not move the position
+func TestFailingCommand(t *testing.T) {
+	h := history.New(&[]int{}, 0)
+	h.Do(&push{Value: 1})
+	if err := h.Do(&push{Value: 2, Fail: true}); !errors.Is(err, errRefused) {
+		t.Fatalf("Do of a failing command returned %v", err)
+	}
+	check(t, h, []int{1}, true, false)
+	if h.Len() != 1 {
+		t.Fatalf("a failed command was recorded, Len is %d", h.Len())
+	}
+
+	command := &push{Value: 2}
+	h.Do(command)
+	command.Fail = true
+	if err := h.Undo(); !errors.Is(err, errRefused) {
+		t.Fatalf("Undo of a failing command returned %v", err)
+	}
+	check(t, h, []int{1, 2}, true, false)
+
+	command.Fail = false
+	h.Undo()
+	command.Fail = true
+	if err := h.Redo(); !errors.Is(err, errRefused) {
+		t.Fatalf("Redo of a failing command returned %v", err)
+	}
+	check(t, h, []int{1}, true, true)
+}
+
+// TestMaxDepth checks that only the last maxDepth commands can be undone and the evicted ones stay applied
+func TestMaxDepth(t *testing.T) {
+	h := history.New(&[]int{}, 2)
+	for i := 1; i <= 5; i++ {
+		h.Do(&push{Value: i})
+		if want := min(i, 2); h.Len() != want {
+			t.Fatalf("after %d commands Len is %d, want %d", i, h.Len(), want)
+		}
+	}
+
+	h.Undo()
+	h.Undo()
+	check(t, h, []int{1, 2, 3}, false, true)
+
+	h.Redo()
+	h.Redo()
+	check(t, h, []int{1, 2, 3, 4, 5}, true, false)
+}
+
+func TestCheckpoint(t *testing.T) {
+	h := history.New(&[]int{}, 0)
+	h.Checkpoint("start")
+	h.Do(&push{Value: 1})
+	h.Do(&push{Value: 2})
+	h.Checkpoint("two")
+	h.Do(&push{Value: 3})
+
+	if err := h.RestoreCheckpoint("start"); err != nil {
+		t.Fatal(err)
+	}
+	check(t, h, []int{}, false, true)
+	if err := h.RestoreCheckpoint("two"); err != nil {
+		t.Fatal(err)
+	}
+	check(t, h, []int{1, 2}, true, true)
+
+	// The same name moves the checkpoint
+	h.Redo()
+	h.Checkpoint("two")
+	h.RestoreCheckpoint("start")
+	h.RestoreCheckpoint("two")
+	check(t, h, []int{1, 2, 3}, true, false)
+
+	if err := h.RestoreCheckpoint("missing"); !errors.Is(err, history.ErrUnknownCheckpoint) {
+		t.Fatalf("RestoreCheckpoint of an unknown name returned %v", err)
+	}
+
+	// A checkpoint on a pruned command is forgotten, one before the new branch is kept
+	h.Undo()
+	h.Do(&push{Value: 4})
+	if err := h.RestoreCheckpoint("two"); !errors.Is(err, history.ErrUnknownCheckpoint) {
+		t.Fatalf("RestoreCheckpoint of a pruned command returned %v", err)
+	}
+	h.RestoreCheckpoint("start")
+	check(t, h, []int{}, false, true)
+}
+
+/*
+TestCheckpointEviction checks the checkpoints once their command is evicted by the max depth
+A checkpoint on the evicted command moves to the new starting point, a checkpoint on the old starting point is dropped
+*/
+func TestCheckpointEviction(t *testing.T) {
+	h := history.New(&[]int{}, 2)
+	h.Checkpoint("start")
+	h.Do(&push{Value: 1})
+	h.Checkpoint("one")
+	h.Do(&push{Value: 2})
+	h.Checkpoint("two")
+	h.Do(&push{Value: 3})
+
+	if err := h.RestoreCheckpoint("start"); !errors.Is(err, history.ErrUnknownCheckpoint) {
+		t.Fatalf("RestoreCheckpoint before the evicted command returned %v", err)
+	}
+	if err := h.RestoreCheckpoint("one"); err != nil {
+		t.Fatalf("RestoreCheckpoint of the evicted command: %v", err)
+	}
+	check(t, h, []int{1}, false, true)
+	if err := h.RestoreCheckpoint("two"); err != nil {
+		t.Fatal(err)
+	}
+	check(t, h, []int{1, 2}, true, true)
+
+	h.Do(&push{Value: 4})
+	h.Do(&push{Value: 5})
+	if err := h.RestoreCheckpoint("one"); !errors.Is(err, history.ErrUnknownCheckpoint) {
+		t.Fatalf("RestoreCheckpoint of a starting point that was evicted too returned %v", err)
+	}
+	if err := h.RestoreCheckpoint("two"); err != nil {
+		t.Fatal(err)
+	}
+	check(t, h, []int{1, 2}, false, true)
+}
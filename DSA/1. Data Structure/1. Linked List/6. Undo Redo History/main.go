@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+
+	"undoredo/history"
+	"undoredo/piecetable"
+)
+
+// show prints the document with the cursor drawn as a "|"
+func show(label string, buffer *piecetable.Buffer) {
+	text := buffer.String()
+	fmt.Printf("%-10s : %s|%s \n", label, text[:buffer.Cursor], text[buffer.Cursor:])
+}
+
+func main() {
+	buffer := piecetable.New("Hello World")
+	editor := history.New(buffer, 100)
+
+	editor.Do(&piecetable.InsertCommand{Pos: 5, Text: ","})
+	show("insert", buffer)
+
+	editor.Checkpoint("greeting")
+
+	editor.Do(&piecetable.DeleteCommand{Pos: 7, Length: 5})
+	editor.Do(&piecetable.InsertCommand{Pos: 7, Text: "Gophers"})
+	show("replace", buffer)
+
+	editor.Undo()
+	show("undo", buffer)
+	editor.Undo()
+	show("undo", buffer)
+	editor.Redo()
+	show("redo", buffer)
+
+	// A new edit after an undo prunes the redo branch
+	editor.Do(&piecetable.InsertCommand{Pos: 7, Text: "Go"})
+	show("new edit", buffer)
+	if err := editor.Redo(); err != nil {
+		fmt.Println("Redo :", err)
+	}
+
+	editor.RestoreCheckpoint("greeting")
+	show("checkpoint", buffer)
+
+	// With a max depth of 2 only the last two edits can be undone
+	short := history.New(piecetable.New(""), 2)
+	for _, word := range []string{"one ", "two ", "three "} {
+		short.Do(&piecetable.InsertCommand{Pos: short.State().Len(), Text: word})
+	}
+	for short.CanUndo() {
+		short.Undo()
+	}
+	show("max depth", short.State())
+}
@@ -0,0 +1,72 @@
+package piecetable
+
+/*
+InsertCommand inserts Text at Pos and moves the cursor to the end of the inserted text
+Undo removes the text again and puts the cursor back where it was before the edit
+It implements history.Command[*Buffer]
+*/
+type InsertCommand struct {
+	Pos          int
+	Text         string
+	cursorBefore int
+}
+
+// Do inserts the text, remembering the cursor so Undo can restore it
+func (command *InsertCommand) Do(buffer *Buffer) error {
+	if err := buffer.Insert(command.Pos, command.Text); err != nil {
+		return err
+	}
+
+	command.cursorBefore = buffer.Cursor
+	buffer.Cursor = command.Pos + len(command.Text)
+	return nil
+}
+
+// Undo deletes the inserted text and moves the cursor back to where it was before Do
+func (command *InsertCommand) Undo(buffer *Buffer) error {
+	if err := buffer.Delete(command.Pos, len(command.Text)); err != nil {
+		return err
+	}
+
+	buffer.Cursor = command.cursorBefore
+	return nil
+}
+
+/*
+DeleteCommand removes Length bytes starting at Pos and moves the cursor to Pos
+It remembers the removed text, so Undo can insert it again and restore the cursor
+It implements history.Command[*Buffer]
+*/
+type DeleteCommand struct {
+	Pos          int
+	Length       int
+	deleted      string
+	cursorBefore int
+}
+
+// Do deletes the range, keeping the removed text and the cursor so Undo can restore both
+func (command *DeleteCommand) Do(buffer *Buffer) error {
+	deleted, err := buffer.Slice(command.Pos, command.Pos+command.Length)
+	if err != nil {
+		return err
+	}
+
+	if err := buffer.Delete(command.Pos, command.Length); err != nil {
+		return err
+	}
+
+	command.deleted = deleted
+	command.cursorBefore = buffer.Cursor
+	buffer.Cursor = command.Pos
+	return nil
+}
+
+// Undo inserts the removed text again at Pos and moves the cursor back to where it was before Do
+func (command *DeleteCommand) Undo(buffer *Buffer) error {
+	if err := buffer.Insert(command.Pos, command.deleted); err != nil {
+		return err
+	}
+
+	buffer.Cursor = command.cursorBefore
+	return nil
+}
@@ -0,0 +1,146 @@
+/*
+Package piecetable provides a small piece-table text buffer and the edit commands to use it with the history package
+A piece table never rewrites text: the original text is kept read-only, inserted text is appended to an add buffer,
+and the document is described by a sequence of pieces that point into one of the two buffers
+All positions are byte offsets
+*/
+package piecetable
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// ErrOutOfRange is returned when a position or length falls outside the document
+var ErrOutOfRange = errors.New("piecetable: position out of range")
+
+// source tells which buffer a piece points into
+type source int
+
+const (
+	original source = iota
+	added
+)
+
+// piece is a run of length bytes starting at start in one of the buffers
+type piece struct {
+	source source
+	start  int
+	length int
+}
+
+// Buffer is a text document stored as a piece table, Cursor is the byte offset of the caret
+type Buffer struct {
+	original string
+	add      strings.Builder
+	pieces   []piece
+	length   int
+	Cursor   int
+}
+
+// New returns a buffer holding text with the cursor at the end
+func New(text string) *Buffer {
+	buffer := &Buffer{original: text, length: len(text), Cursor: len(text)}
+	if text != "" {
+		buffer.pieces = []piece{{source: original, start: 0, length: len(text)}}
+	}
+	return buffer
+}
+
+// Len returns the length of the document in bytes
+func (buffer *Buffer) Len() int {
+	return buffer.length
+}
+
+// String returns the whole document
+func (buffer *Buffer) String() string {
+	var text strings.Builder
+	text.Grow(buffer.length)
+	for _, p := range buffer.pieces {
+		text.WriteString(buffer.text(p))
+	}
+	return text.String()
+}
+
+// Slice returns the text between the byte offsets from and to
+func (buffer *Buffer) Slice(from, to int) (string, error) {
+	if from < 0 || to > buffer.length || from > to {
+		return "", ErrOutOfRange
+	}
+	return buffer.String()[from:to], nil
+}
+
+/*
+Insert inserts text at the byte offset pos
+The text is appended to the add buffer and a new piece pointing at it is placed at pos,
+splitting the piece that contains pos in two if needed
+*/
+func (buffer *Buffer) Insert(pos int, text string) error {
+	if pos < 0 || pos > buffer.length {
+		return ErrOutOfRange
+	}
+
+	if text == "" {
+		return nil
+	}
+
+	inserted := piece{source: added, start: buffer.add.Len(), length: len(text)}
+	buffer.add.WriteString(text)
+
+	index := buffer.split(pos)
+	buffer.pieces = slices.Insert(buffer.pieces, index, inserted)
+	buffer.length += len(text)
+	return nil
+}
+
+/*
+Delete removes length bytes starting at the byte offset pos
+The pieces are split at both ends of the range and the pieces in between are dropped,
+the text they pointed to stays in the buffers so it can be restored by an undo
+*/
+func (buffer *Buffer) Delete(pos, length int) error {
+	if pos < 0 || length < 0 || pos+length > buffer.length {
+		return ErrOutOfRange
+	}
+
+	if length == 0 {
+		return nil
+	}
+
+	from := buffer.split(pos)
+	to := buffer.split(pos + length)
+	buffer.pieces = slices.Delete(buffer.pieces, from, to)
+	buffer.length -= length
+	return nil
+}
+
+/*
+split makes sure a piece boundary exists at the byte offset pos and returns the index of the piece that starts there
+A piece containing pos in its middle is cut into two pieces
+*/
+func (buffer *Buffer) split(pos int) int {
+	offset := 0
+	for i, p := range buffer.pieces {
+		if pos == offset {
+			return i
+		}
+
+		if pos < offset+p.length {
+			left := piece{source: p.source, start: p.start, length: pos - offset}
+			right := piece{source: p.source, start: p.start + left.length, length: p.length - left.length}
+			buffer.pieces = slices.Replace(buffer.pieces, i, i+1, left, right)
+			return i + 1
+		}
+		offset += p.length
+	}
+	return len(buffer.pieces)
+}
+
+// text returns the bytes a piece points to
+func (buffer *Buffer) text(p piece) string {
+	if p.source == original {
+		return buffer.original[p.start : p.start+p.length]
+	}
+	return buffer.add.String()[p.start : p.start+p.length]
+}
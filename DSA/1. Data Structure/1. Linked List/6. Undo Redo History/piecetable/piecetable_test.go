@@ -0,0 +1,135 @@
+package piecetable_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"undoredo/history"
+	"undoredo/piecetable"
+)
+
+// check fails t unless the buffer holds want, read whole and through Slice at random bounds
+func check(t *testing.T, random *rand.Rand, buffer *piecetable.Buffer, want string) {
+	t.Helper()
+
+	if got := buffer.String(); got != want || buffer.Len() != len(want) {
+		t.Fatalf("got %q with Len %d, want %q", got, buffer.Len(), want)
+	}
+	from := random.IntN(len(want) + 1)
+	to := from + random.IntN(len(want)-from+1)
+	if got, err := buffer.Slice(from, to); err != nil || got != want[from:to] {
+		t.Fatalf("Slice(%d, %d) of %q = %q, %v", from, to, want, got, err)
+	}
+}
+
+/*
+TestEdits inserts and deletes at random positions against a plain string
+Every edit lands inside or across the pieces earlier edits left behind, so the splits at piece boundaries are covered
+*/
+func TestEdits(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+	for _, original := range []string{"", "Hello World", "the quick brown fox jumps over the lazy dog"} {
+		buffer, want := piecetable.New(original), original
+		for step := range 2000 {
+			pos := random.IntN(len(want) + 1)
+			if random.IntN(2) == 0 || len(want) == 0 {
+				text := "abcdefgh"[:random.IntN(9)]
+				if err := buffer.Insert(pos, text); err != nil {
+					t.Fatalf("step %d: Insert(%d, %q): %v", step, pos, text, err)
+				}
+				want = want[:pos] + text + want[pos:]
+			} else {
+				length := random.IntN(min(len(want)-pos, 12) + 1)
+				if err := buffer.Delete(pos, length); err != nil {
+					t.Fatalf("step %d: Delete(%d, %d): %v", step, pos, length, err)
+				}
+				want = want[:pos] + want[pos+length:]
+			}
+			check(t, random, buffer, want)
+		}
+	}
+}
+
+func TestOutOfRange(t *testing.T) {
+	buffer := piecetable.New("abc")
+	buffer.Insert(3, "def")
+
+	for _, err := range []error{
+		buffer.Insert(-1, "x"),
+		buffer.Insert(7, "x"),
+		buffer.Delete(-1, 1),
+		buffer.Delete(2, -1),
+		buffer.Delete(4, 3),
+	} {
+		if !errors.Is(err, piecetable.ErrOutOfRange) {
+			t.Fatalf("got %v, want %v", err, piecetable.ErrOutOfRange)
+		}
+	}
+	for _, bounds := range [][2]int{{-1, 2}, {2, 7}, {4, 3}} {
+		if _, err := buffer.Slice(bounds[0], bounds[1]); !errors.Is(err, piecetable.ErrOutOfRange) {
+			t.Fatalf("Slice(%d, %d) returned %v", bounds[0], bounds[1], err)
+		}
+	}
+	if got := buffer.String(); got != "abcdef" {
+		t.Fatalf("rejected edits changed the buffer to %q", got)
+	}
+}
+
+/*
+TestCommands runs random edit commands through a history and then undoes all of them
+Every undo must bring back both the text and the cursor from before the command, and redoing all of them the final ones
+*/
+func TestCommands(t *testing.T) {
+	random := rand.New(rand.NewPCG(3, 4))
+	buffer := piecetable.New("Hello World")
+	editor := history.New(buffer, 0)
+
+	type snapshot struct {
+		text   string
+		cursor int
+	}
+	snapshots := []snapshot{{buffer.String(), buffer.Cursor}}
+	for step := range 500 {
+		text := buffer.String()
+		pos := random.IntN(len(text) + 1)
+		var command history.Command[*piecetable.Buffer]
+		if random.IntN(2) == 0 {
+			inserted := "xyz"[:1+random.IntN(3)]
+			command = &piecetable.InsertCommand{Pos: pos, Text: inserted}
+			text = text[:pos] + inserted + text[pos:]
+			pos += len(inserted)
+		} else {
+			length := random.IntN(len(text) - pos + 1)
+			command = &piecetable.DeleteCommand{Pos: pos, Length: length}
+			text = text[:pos] + text[pos+length:]
+		}
+
+		if err := editor.Do(command); err != nil {
+			t.Fatalf("step %d: %v", step, err)
+		}
+		if buffer.String() != text || buffer.Cursor != pos {
+			t.Fatalf("step %d: got %q with the cursor at %d, want %q at %d", step, buffer.String(), buffer.Cursor, text, pos)
+		}
+		snapshots = append(snapshots, snapshot{text, pos})
+	}
+
+	for i := len(snapshots) - 2; i >= 0; i-- {
+		if err := editor.Undo(); err != nil {
+			t.Fatal(err)
+		}
+		if got := (snapshot{buffer.String(), buffer.Cursor}); got != snapshots[i] {
+			t.Fatalf("undo to %d: got %q at %d, want %q at %d", i, got.text, got.cursor, snapshots[i].text, snapshots[i].cursor)
+		}
+	}
+	for editor.CanRedo() {
+		editor.Redo()
+	}
+	if got := (snapshot{buffer.String(), buffer.Cursor}); got != snapshots[len(snapshots)-1] {
+		t.Fatalf("after redoing everything: got %q at %d", got.text, got.cursor)
+	}
+
+	if err := editor.Do(&piecetable.DeleteCommand{Pos: buffer.Len(), Length: 1}); !errors.Is(err, piecetable.ErrOutOfRange) {
+		t.Fatalf("DeleteCommand past the end returned %v", err)
+	}
+}
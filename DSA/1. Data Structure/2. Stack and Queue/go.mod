@@ -0,0 +1,13 @@
+module stackqueue
+
+go 1.23.4
+
+require (
+	double v0.0.0
+	generic v0.0.0
+)
+
+replace (
+	double => "../1. Linked List/2. Double Linked List"
+	generic => "../1. Linked List/1. Single Linked List/1. With Generic"
+)
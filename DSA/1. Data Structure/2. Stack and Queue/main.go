@@ -0,0 +1,130 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	"stackqueue/queue"
+	"stackqueue/stack"
+)
+
+// drain enqueues from several producers and dequeues from several consumers at the same time,
+// run it with "go run -race ." to let the race detector check the concurrent queues
+func drain(enqueue func(int), dequeue func() (int, error)) int {
+	const producers, perProducer = 4, 1000
+
+	var produced sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		produced.Add(1)
+		go func() {
+			defer produced.Done()
+			for i := 0; i < perProducer; i++ {
+				enqueue(p*perProducer + i)
+			}
+		}()
+	}
+
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	done := make(chan struct{})
+
+	var consumed sync.WaitGroup
+	for c := 0; c < 4; c++ {
+		consumed.Add(1)
+		go func() {
+			defer consumed.Done()
+			for {
+				data, err := dequeue()
+				if err == nil {
+					mu.Lock()
+					seen[data] = true
+					mu.Unlock()
+					continue
+				}
+
+				select {
+				case <-done:
+					// Producers are finished, empty means really empty
+					if _, err := dequeue(); err != nil {
+						return
+					}
+				default:
+				}
+			}
+		}()
+	}
+
+	produced.Wait()
+	close(done)
+	consumed.Wait()
+	return len(seen)
+}
+
+func main() {
+	var s stack.Stack[int]
+	for i := 1; i <= 5; i++ {
+		s.Push(i)
+	}
+	top, _ := s.Peek()
+	fmt.Println("Stack      :", top, s.Len())
+	for !s.IsEmpty() {
+		data, _ := s.Pop()
+		fmt.Print(data, " ")
+	}
+	fmt.Println()
+	if _, err := s.Pop(); err != nil {
+		fmt.Println("Pop        :", err)
+	}
+
+	bounded := stack.NewBounded[string](2)
+	bounded.Push("a")
+	bounded.Push("b")
+	if err := bounded.Push("c"); err != nil {
+		fmt.Println("Push       :", err)
+	}
+
+	var q queue.Queue[int]
+	for i := 1; i <= 5; i++ {
+		q.Enqueue(i)
+	}
+	front, _ := q.Peek()
+	fmt.Print("Queue      : ", front, " | ")
+	for data := range q.Values() {
+		fmt.Print(data, " ")
+	}
+	fmt.Println()
+
+	var twoStack queue.TwoStackQueue[int]
+	twoStack.Enqueue(1)
+	twoStack.Enqueue(2)
+	first, _ := twoStack.Dequeue()
+	twoStack.Enqueue(3)
+	fmt.Print("TwoStack   : ", first, " | ")
+	for data := range twoStack.Values() {
+		fmt.Print(data, " ")
+	}
+	fmt.Println()
+
+	boundedQueue := queue.NewBounded[int](1)
+	boundedQueue.Enqueue(1)
+	if err := boundedQueue.Enqueue(2); err != nil {
+		fmt.Println("Enqueue    :", err)
+	}
+
+	var deque queue.Deque[int]
+	deque.PushBack(2)
+	deque.PushFront(1)
+	deque.PushBack(3)
+	back, _ := deque.PopBack()
+	fmt.Print("Deque      : ", back, " | ")
+	for data := range deque.Backward() {
+		fmt.Print(data, " ")
+	}
+	fmt.Println()
+
+	var locked queue.ConcurrentQueue[int]
+	fmt.Println("Mutex      :", drain(locked.Enqueue, locked.Dequeue), "values")
+
+	lockFree := queue.NewLockFree[int]()
+	fmt.Println("Lock-free  :", drain(lockFree.Enqueue, lockFree.Dequeue), "values")
+}
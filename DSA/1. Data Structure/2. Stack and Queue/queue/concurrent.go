@@ -0,0 +1,173 @@
+package queue
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+/*
+ConcurrentQueue is a Queue guarded by a mutex, it is safe to share between goroutines
+Every operation takes the lock, which keeps it simple and is usually fast enough under moderate contention
+*/
+type ConcurrentQueue[T comparable] struct {
+	mu    sync.Mutex
+	queue Queue[T]
+}
+
+// Enqueue adds data at the back of the queue
+func (queue *ConcurrentQueue[T]) Enqueue(data T) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	queue.queue.Enqueue(data)
+}
+
+// Dequeue removes the front of the queue and returns it, or ErrEmpty
+func (queue *ConcurrentQueue[T]) Dequeue() (T, error) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	return queue.queue.Dequeue()
+}
+
+// Peek returns the front of the queue without removing it, or ErrEmpty
+func (queue *ConcurrentQueue[T]) Peek() (T, error) {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	return queue.queue.Peek()
+}
+
+// Len returns the number of values in the queue
+func (queue *ConcurrentQueue[T]) Len() int {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+
+	return queue.queue.Len()
+}
+
+// IsEmpty reports whether the queue holds no values
+func (queue *ConcurrentQueue[T]) IsEmpty() bool {
+	return queue.Len() == 0
+}
+
+// lockFreeNode is a node of the lock-free queue, its next link is updated with compare-and-swap
+type lockFreeNode[T any] struct {
+	data T
+	next atomic.Pointer[lockFreeNode[T]]
+}
+
+/*
+LockFreeQueue is the Michael-Scott non-blocking queue, it is safe to share between goroutines
+head always points to a dummy node whose successor is the front of the queue, tail points to the last node or close to it
+Enqueue links the new node after the last node with a compare-and-swap and then tries to swing tail forward
+Dequeue swings head forward with a compare-and-swap, the old successor becomes the new dummy
+Any goroutine that sees tail lagging behind helps to move it, so no goroutine ever waits on a lock
+The zero value is an empty queue ready to use, the dummy node is installed by the first operation
+*/
+type LockFreeQueue[T any] struct {
+	head atomic.Pointer[lockFreeNode[T]]
+	tail atomic.Pointer[lockFreeNode[T]]
+	size atomic.Int64
+}
+
+// NewLockFree returns an empty lock-free queue
+func NewLockFree[T any]() *LockFreeQueue[T] {
+	queue := &LockFreeQueue[T]{}
+	queue.lazyInit()
+	return queue
+}
+
+/*
+lazyInit installs the dummy node of a zero value queue, the first goroutine to swap it into head wins
+tail is set from head afterwards: head cannot move before tail is set, since Dequeue needs a node that Enqueue
+can only link after reading a non-nil tail
+*/
+func (queue *LockFreeQueue[T]) lazyInit() {
+	if queue.tail.Load() != nil {
+		return
+	}
+	queue.head.CompareAndSwap(nil, &lockFreeNode[T]{})
+	queue.tail.CompareAndSwap(nil, queue.head.Load())
+}
+
+/*
+Enqueue adds data at the back of the queue
+The counter goes up before the node is linked, so no Dequeue can count the node down before it was counted up
+*/
+func (queue *LockFreeQueue[T]) Enqueue(data T) {
+	queue.lazyInit()
+	node := &lockFreeNode[T]{data: data}
+	queue.size.Add(1)
+	for {
+		tail := queue.tail.Load()
+		next := tail.next.Load()
+		if tail != queue.tail.Load() {
+			continue
+		}
+
+		if next != nil {
+			queue.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		if tail.next.CompareAndSwap(nil, node) {
+			queue.tail.CompareAndSwap(tail, node)
+			return
+		}
+	}
+}
+
+// Dequeue removes the front of the queue and returns it, or ErrEmpty
+func (queue *LockFreeQueue[T]) Dequeue() (T, error) {
+	queue.lazyInit()
+	for {
+		head := queue.head.Load()
+		tail := queue.tail.Load()
+		next := head.next.Load()
+		if head != queue.head.Load() {
+			continue
+		}
+
+		if next == nil {
+			var zero T
+			return zero, ErrEmpty
+		}
+
+		if head == tail {
+			queue.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		data := next.data
+		if queue.head.CompareAndSwap(head, next) {
+			queue.size.Add(-1)
+			return data, nil
+		}
+	}
+}
+
+// Peek returns the front of the queue without removing it, or ErrEmpty
+func (queue *LockFreeQueue[T]) Peek() (T, error) {
+	queue.lazyInit()
+	next := queue.head.Load().next.Load()
+	if next == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return next.data, nil
+}
+
+/*
+Len returns the number of values in the queue, under concurrent use it is only a snapshot
+Enqueue counts a value before linking it, so Len can include values that are not linked yet but it is never negative
+*/
+func (queue *LockFreeQueue[T]) Len() int {
+	return int(queue.size.Load())
+}
+
+// IsEmpty reports whether the queue holds no values
+func (queue *LockFreeQueue[T]) IsEmpty() bool {
+	queue.lazyInit()
+	return queue.head.Load().next.Load() == nil
+}
@@ -0,0 +1,177 @@
+package queue_test
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"stackqueue/queue"
+)
+
+// concurrent is what the concurrent queues have in common
+type concurrent interface {
+	Enqueue(data int)
+	Dequeue() (int, error)
+	Len() int
+}
+
+const (
+	producers = 4
+	consumers = 4
+	perWorker = 2000
+)
+
+/*
+stress runs producers and consumers on q at the same time and then checks that every value came out exactly once,
+and that every consumer got the values of each producer in the order they were enqueued
+A watcher goroutine keeps reading Len, which must never be negative, and every consumer reads it right after a Dequeue,
+when an Enqueue of the same value might not have counted it yet
+*/
+func stress(t *testing.T, q concurrent) {
+	var consumed atomic.Int64
+	var wg sync.WaitGroup
+
+	for p := range producers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWorker {
+				q.Enqueue(p*perWorker + i)
+			}
+		}()
+	}
+
+	received := make([][]int, consumers)
+	for c := range consumers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for consumed.Load() < producers*perWorker {
+				value, err := q.Dequeue()
+				if errors.Is(err, queue.ErrEmpty) {
+					runtime.Gosched()
+					continue
+				}
+				if err != nil {
+					t.Errorf("Dequeue: %v", err)
+					return
+				}
+				if n := q.Len(); n < 0 {
+					t.Errorf("Len is %d right after a Dequeue", n)
+					return
+				}
+				received[c] = append(received[c], value)
+				consumed.Add(1)
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	watched := make(chan int)
+	go func() {
+		smallest := 0
+		for {
+			select {
+			case <-done:
+				watched <- smallest
+				return
+			default:
+				smallest = min(smallest, q.Len())
+			}
+		}
+	}()
+
+	wg.Wait()
+	close(done)
+	if smallest := <-watched; smallest < 0 {
+		t.Errorf("Len went down to %d", smallest)
+	}
+
+	seen := make([]int, producers*perWorker)
+	for c, values := range received {
+		last := make([]int, producers)
+		for p := range last {
+			last[p] = -1
+		}
+		for _, value := range values {
+			seen[value]++
+			p, i := value/perWorker, value%perWorker
+			if i <= last[p] {
+				t.Fatalf("consumer %d got %d after %d from producer %d", c, i, last[p], p)
+			}
+			last[p] = i
+		}
+	}
+	for value, count := range seen {
+		if count != 1 {
+			t.Fatalf("value %d came out %d times", value, count)
+		}
+	}
+
+	if _, err := q.Dequeue(); !errors.Is(err, queue.ErrEmpty) {
+		t.Fatalf("Dequeue on the drained queue: %v, want ErrEmpty", err)
+	}
+	if q.Len() != 0 {
+		t.Fatalf("Len %d on the drained queue", q.Len())
+	}
+}
+
+func TestConcurrentQueue(t *testing.T) {
+	stress(t, &queue.ConcurrentQueue[int]{})
+}
+
+func TestLockFreeQueue(t *testing.T) {
+	stress(t, queue.NewLockFree[int]())
+}
+
+// TestLockFreeQueueZeroValue starts every goroutine on a zero value queue, so they race to install the dummy node
+func TestLockFreeQueueZeroValue(t *testing.T) {
+	for range 50 {
+		var q queue.LockFreeQueue[int]
+		var wg sync.WaitGroup
+		for i := range 8 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if i%2 == 0 {
+					q.Enqueue(i)
+				} else {
+					q.Dequeue()
+				}
+				q.IsEmpty()
+				q.Peek()
+			}()
+		}
+		wg.Wait()
+	}
+
+	var q queue.LockFreeQueue[int]
+	if !q.IsEmpty() || q.Len() != 0 {
+		t.Fatal("zero value queue is not empty")
+	}
+	q.Enqueue(1)
+	if value, err := q.Dequeue(); value != 1 || err != nil {
+		t.Fatalf("Dequeue = %d, %v, want 1", value, err)
+	}
+}
+
+// benchmarkQueue runs enqueue/dequeue pairs from all goroutines at once
+func benchmarkQueue(b *testing.B, q concurrent) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			q.Enqueue(1)
+			q.Dequeue()
+		}
+	})
+}
+
+func BenchmarkConcurrentQueue(b *testing.B) {
+	benchmarkQueue(b, &queue.ConcurrentQueue[int]{})
+}
+
+func BenchmarkLockFreeQueue(b *testing.B) {
+	benchmarkQueue(b, queue.NewLockFree[int]())
+}
@@ -0,0 +1,78 @@
+package queue
+
+import (
+	"iter"
+
+	"double/doublylinkedlist"
+)
+
+// Deque is a double ended queue, values can be added and removed at both ends in O(1)
+type Deque[T comparable] struct {
+	list doublylinkedlist.DoublyLinkedList[T]
+}
+
+// PushFront adds data at the front of the deque
+func (deque *Deque[T]) PushFront(data T) {
+	deque.list.PushFront(data)
+}
+
+// PushBack adds data at the back of the deque
+func (deque *Deque[T]) PushBack(data T) {
+	deque.list.PushBack(data)
+}
+
+// PopFront removes the front of the deque and returns it, or ErrEmpty
+func (deque *Deque[T]) PopFront() (T, error) {
+	data, err := deque.list.PopFront()
+	if err != nil {
+		return data, ErrEmpty
+	}
+	return data, nil
+}
+
+// PopBack removes the back of the deque and returns it, or ErrEmpty
+func (deque *Deque[T]) PopBack() (T, error) {
+	data, err := deque.list.PopBack()
+	if err != nil {
+		return data, ErrEmpty
+	}
+	return data, nil
+}
+
+// PeekFront returns the front of the deque without removing it, or ErrEmpty
+func (deque *Deque[T]) PeekFront() (T, error) {
+	data, err := deque.list.Front()
+	if err != nil {
+		return data, ErrEmpty
+	}
+	return data, nil
+}
+
+// PeekBack returns the back of the deque without removing it, or ErrEmpty
+func (deque *Deque[T]) PeekBack() (T, error) {
+	data, err := deque.list.Back()
+	if err != nil {
+		return data, ErrEmpty
+	}
+	return data, nil
+}
+
+// Len returns the number of values in the deque
+func (deque *Deque[T]) Len() int {
+	return deque.list.Len()
+}
+
+// IsEmpty reports whether the deque holds no values
+func (deque *Deque[T]) IsEmpty() bool {
+	return deque.list.Len() == 0
+}
+
+// Values returns an iterator over the values from the front to the back of the deque
+func (deque *Deque[T]) Values() iter.Seq[T] {
+	return deque.list.Values()
+}
+
+// Backward returns an iterator over the values from the back to the front of the deque
+func (deque *Deque[T]) Backward() iter.Seq[T] {
+	return deque.list.Backward()
+}
@@ -0,0 +1,125 @@
+/*
+Package queue provides generic FIFO queues and a double ended queue built on the linked list packages
+Queue appends at the Tail and removes at the Head of a singly linked list, TwoStackQueue is the classic
+queue made of two stacks, Deque wraps the doubly linked list, and ConcurrentQueue / LockFreeQueue are safe
+to share between goroutines
+*/
+package queue
+
+import (
+	"errors"
+	"iter"
+
+	"generic/linkedlist"
+)
+
+// Sentinel errors returned by the queue operations
+var (
+	ErrEmpty = errors.New("queue: queue is empty")
+	ErrFull  = errors.New("queue: queue is full")
+)
+
+/*
+Queue is a first in, first out collection, the zero value is an empty queue ready to use
+The list tracks its Tail, so both Enqueue and Dequeue are O(1)
+*/
+type Queue[T comparable] struct {
+	list linkedlist.LinkedList[T]
+}
+
+// Enqueue adds data at the back of the queue in O(1)
+func (queue *Queue[T]) Enqueue(data T) {
+	queue.list.PushBack(data)
+}
+
+// Dequeue removes the front of the queue in O(1) and returns it, or ErrEmpty
+func (queue *Queue[T]) Dequeue() (T, error) {
+	data, err := queue.list.PopFront()
+	if err != nil {
+		return data, ErrEmpty
+	}
+	return data, nil
+}
+
+// Peek returns the front of the queue without removing it, or ErrEmpty
+func (queue *Queue[T]) Peek() (T, error) {
+	if queue.list.Head == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return queue.list.Head.Data, nil
+}
+
+// Len returns the number of values in the queue
+func (queue *Queue[T]) Len() int {
+	return queue.list.Len()
+}
+
+// IsEmpty reports whether the queue holds no values
+func (queue *Queue[T]) IsEmpty() bool {
+	return queue.list.Len() == 0
+}
+
+// Values returns an iterator over the values from the front to the back of the queue
+func (queue *Queue[T]) Values() iter.Seq[T] {
+	return queue.list.Values()
+}
+
+/*
+BoundedQueue is a Queue that holds at most a fixed number of values
+Enqueue returns ErrFull instead of growing past the capacity
+*/
+type BoundedQueue[T comparable] struct {
+	queue    Queue[T]
+	capacity int
+}
+
+// NewBounded returns an empty queue that holds at most capacity values
+func NewBounded[T comparable](capacity int) *BoundedQueue[T] {
+	return &BoundedQueue[T]{capacity: capacity}
+}
+
+// Enqueue adds data at the back of the queue, or returns ErrFull if the queue is at its capacity
+func (queue *BoundedQueue[T]) Enqueue(data T) error {
+	if queue.IsFull() {
+		return ErrFull
+	}
+
+	queue.queue.Enqueue(data)
+	return nil
+}
+
+// Dequeue removes the front of the queue and returns it, or ErrEmpty
+func (queue *BoundedQueue[T]) Dequeue() (T, error) {
+	return queue.queue.Dequeue()
+}
+
+// Peek returns the front of the queue without removing it, or ErrEmpty
+func (queue *BoundedQueue[T]) Peek() (T, error) {
+	return queue.queue.Peek()
+}
+
+// Len returns the number of values in the queue
+func (queue *BoundedQueue[T]) Len() int {
+	return queue.queue.Len()
+}
+
+// Cap returns the maximum number of values the queue can hold
+func (queue *BoundedQueue[T]) Cap() int {
+	return queue.capacity
+}
+
+// IsEmpty reports whether the queue holds no values
+func (queue *BoundedQueue[T]) IsEmpty() bool {
+	return queue.queue.IsEmpty()
+}
+
+// IsFull reports whether the queue is at its capacity
+func (queue *BoundedQueue[T]) IsFull() bool {
+	return queue.queue.Len() >= queue.capacity
+}
+
+// Values returns an iterator over the values from the front to the back of the queue
+func (queue *BoundedQueue[T]) Values() iter.Seq[T] {
+	return queue.queue.Values()
+}
@@ -0,0 +1,199 @@
+package queue_test
+
+import (
+	"errors"
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"stackqueue/queue"
+)
+
+// fifo is what the single threaded queues have in common, Enqueue is passed on its own since only BoundedQueue can fail
+type fifo interface {
+	Dequeue() (int, error)
+	Peek() (int, error)
+	Len() int
+	IsEmpty() bool
+	Values() iter.Seq[int]
+}
+
+// check fails t unless q holds want, from the front to the back
+func check(t *testing.T, q fifo, want []int) {
+	t.Helper()
+
+	if got := slices.Collect(q.Values()); !slices.Equal(got, want) || q.Len() != len(want) || q.IsEmpty() != (len(want) == 0) {
+		t.Fatalf("got %v with Len %d, want %v", got, q.Len(), want)
+	}
+	front, err := q.Peek()
+	if len(want) == 0 && !errors.Is(err, queue.ErrEmpty) {
+		t.Fatalf("Peek of an empty queue returned %v", err)
+	}
+	if len(want) > 0 && (err != nil || front != want[0]) {
+		t.Fatalf("Peek returned %d, %v, want %d", front, err, want[0])
+	}
+}
+
+// run enqueues and dequeues at random against a slice, enqueue reports whether the queue took the value
+func run(t *testing.T, q fifo, enqueue func(int) bool, capacity int) {
+	random := rand.New(rand.NewPCG(1, 2))
+	var want []int
+	for step := range 2000 {
+		if random.IntN(2) == 0 {
+			if taken := enqueue(step); taken != (capacity == 0 || len(want) < capacity) {
+				t.Fatalf("step %d: Enqueue with %d values took the value: %t", step, len(want), taken)
+			}
+			if capacity == 0 || len(want) < capacity {
+				want = append(want, step)
+			}
+		} else {
+			got, err := q.Dequeue()
+			if len(want) == 0 {
+				if !errors.Is(err, queue.ErrEmpty) {
+					t.Fatalf("step %d: Dequeue of an empty queue returned %v", step, err)
+				}
+			} else if err != nil || got != want[0] {
+				t.Fatalf("step %d: Dequeue returned %d, %v, want %d", step, got, err, want[0])
+			} else {
+				want = want[1:]
+			}
+		}
+		check(t, q, want)
+	}
+}
+
+func TestQueue(t *testing.T) {
+	var q queue.Queue[int]
+	run(t, &q, func(v int) bool { q.Enqueue(v); return true }, 0)
+}
+
+func TestBoundedQueue(t *testing.T) {
+	q := queue.NewBounded[int](3)
+	run(t, q, func(v int) bool {
+		err := q.Enqueue(v)
+		if err != nil && !errors.Is(err, queue.ErrFull) {
+			t.Fatalf("Enqueue returned %v", err)
+		}
+		return err == nil
+	}, 3)
+
+	full := queue.NewBounded[int](2)
+	full.Enqueue(1)
+	full.Enqueue(2)
+	if !full.IsFull() || full.Cap() != 2 {
+		t.Fatalf("IsFull %t and Cap %d, want a full queue of 2", full.IsFull(), full.Cap())
+	}
+	if err := full.Enqueue(3); !errors.Is(err, queue.ErrFull) {
+		t.Fatalf("Enqueue on a full queue returned %v", err)
+	}
+	check(t, full, []int{1, 2})
+}
+
+func TestTwoStackQueue(t *testing.T) {
+	var q queue.TwoStackQueue[int]
+	run(t, &q, func(v int) bool { q.Enqueue(v); return true }, 0)
+}
+
+// TestTwoStackQueueRefill checks the order across a refill, with values waiting on both stacks at once
+func TestTwoStackQueueRefill(t *testing.T) {
+	var q queue.TwoStackQueue[int]
+	q.Enqueue(1)
+	q.Enqueue(2)
+	q.Enqueue(3)
+
+	// The first Dequeue moves 1, 2 and 3 to the out stack, 4 and 5 then wait on the in stack behind them
+	if front, _ := q.Dequeue(); front != 1 {
+		t.Fatalf("Dequeue returned %d, want 1", front)
+	}
+	q.Enqueue(4)
+	q.Enqueue(5)
+	check(t, &q, []int{2, 3, 4, 5})
+
+	var order []int
+	for !q.IsEmpty() {
+		front, _ := q.Dequeue()
+		order = append(order, front)
+		if front == 3 {
+			// The out stack is empty now, Peek has to refill it before 6 joins the in stack
+			if next, _ := q.Peek(); next != 4 {
+				t.Fatalf("Peek after draining the out stack returned %d, want 4", next)
+			}
+			q.Enqueue(6)
+		}
+	}
+	if want := []int{2, 3, 4, 5, 6}; !slices.Equal(order, want) {
+		t.Fatalf("got %v, want %v", order, want)
+	}
+
+	q.Enqueue(7)
+	q.Enqueue(8)
+	q.Peek()
+	q.Enqueue(9)
+	// 7 and 8 are on the out stack and 9 on the in stack, breaking out of Values on either of them must stop cleanly
+	for v := range q.Values() {
+		if v == 8 {
+			break
+		}
+	}
+	check(t, &q, []int{7, 8, 9})
+}
+
+func TestDeque(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+	var deque queue.Deque[int]
+	var want []int
+	for step := range 2000 {
+		switch random.IntN(4) {
+		case 0:
+			deque.PushFront(step)
+			want = slices.Insert(want, 0, step)
+		case 1:
+			deque.PushBack(step)
+			want = append(want, step)
+		case 2:
+			got, err := deque.PopFront()
+			if len(want) == 0 {
+				if !errors.Is(err, queue.ErrEmpty) {
+					t.Fatalf("step %d: PopFront of an empty deque returned %v", step, err)
+				}
+				break
+			}
+			if err != nil || got != want[0] {
+				t.Fatalf("step %d: PopFront returned %d, %v, want %d", step, got, err, want[0])
+			}
+			want = want[1:]
+		case 3:
+			got, err := deque.PopBack()
+			if len(want) == 0 {
+				if !errors.Is(err, queue.ErrEmpty) {
+					t.Fatalf("step %d: PopBack of an empty deque returned %v", step, err)
+				}
+				break
+			}
+			if err != nil || got != want[len(want)-1] {
+				t.Fatalf("step %d: PopBack returned %d, %v, want %d", step, got, err, want[len(want)-1])
+			}
+			want = want[:len(want)-1]
+		}
+
+		backward := slices.Clone(want)
+		slices.Reverse(backward)
+		if got := slices.Collect(deque.Values()); !slices.Equal(got, want) || deque.Len() != len(want) || deque.IsEmpty() != (len(want) == 0) {
+			t.Fatalf("step %d: got %v with Len %d, want %v", step, got, deque.Len(), want)
+		}
+		if got := slices.Collect(deque.Backward()); !slices.Equal(got, backward) {
+			t.Fatalf("step %d: Backward got %v, want %v", step, got, backward)
+		}
+
+		front, frontErr := deque.PeekFront()
+		back, backErr := deque.PeekBack()
+		if len(want) == 0 {
+			if !errors.Is(frontErr, queue.ErrEmpty) || !errors.Is(backErr, queue.ErrEmpty) {
+				t.Fatalf("step %d: peeks of an empty deque returned %v and %v", step, frontErr, backErr)
+			}
+		} else if front != want[0] || back != want[len(want)-1] || frontErr != nil || backErr != nil {
+			t.Fatalf("step %d: peeks returned %d and %d, want %d and %d", step, front, back, want[0], want[len(want)-1])
+		}
+	}
+}
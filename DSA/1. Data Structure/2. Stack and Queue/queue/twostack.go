@@ -0,0 +1,89 @@
+package queue
+
+import (
+	"iter"
+
+	"stackqueue/stack"
+)
+
+/*
+TwoStackQueue is a queue made of two stacks
+Enqueue pushes onto the in stack, Dequeue pops from the out stack and refills it from the in stack when it runs empty
+Moving the in stack over reverses it, which turns LIFO order into FIFO order
+Each value is moved at most once, so both operations are O(1) amortized
+*/
+type TwoStackQueue[T comparable] struct {
+	in  stack.Stack[T]
+	out stack.Stack[T]
+}
+
+// Enqueue adds data at the back of the queue in O(1)
+func (queue *TwoStackQueue[T]) Enqueue(data T) {
+	queue.in.Push(data)
+}
+
+// Dequeue removes the front of the queue in O(1) amortized and returns it, or ErrEmpty
+func (queue *TwoStackQueue[T]) Dequeue() (T, error) {
+	queue.refill()
+	data, err := queue.out.Pop()
+	if err != nil {
+		return data, ErrEmpty
+	}
+	return data, nil
+}
+
+// Peek returns the front of the queue without removing it, or ErrEmpty
+func (queue *TwoStackQueue[T]) Peek() (T, error) {
+	queue.refill()
+	data, err := queue.out.Peek()
+	if err != nil {
+		return data, ErrEmpty
+	}
+	return data, nil
+}
+
+// Len returns the number of values in the queue
+func (queue *TwoStackQueue[T]) Len() int {
+	return queue.in.Len() + queue.out.Len()
+}
+
+// IsEmpty reports whether the queue holds no values
+func (queue *TwoStackQueue[T]) IsEmpty() bool {
+	return queue.Len() == 0
+}
+
+/*
+Values returns an iterator over the values from the front to the back of the queue
+The out stack already is in queue order, the in stack is in reverse order so it is collected and walked backwards
+*/
+func (queue *TwoStackQueue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for data := range queue.out.Values() {
+			if !yield(data) {
+				return
+			}
+		}
+
+		pending := make([]T, 0, queue.in.Len())
+		for data := range queue.in.Values() {
+			pending = append(pending, data)
+		}
+		for i := len(pending) - 1; i >= 0; i-- {
+			if !yield(pending[i]) {
+				return
+			}
+		}
+	}
+}
+
+// refill moves every value from the in stack to the out stack when the out stack is empty
+func (queue *TwoStackQueue[T]) refill() {
+	if !queue.out.IsEmpty() {
+		return
+	}
+
+	for !queue.in.IsEmpty() {
+		data, _ := queue.in.Pop()
+		queue.out.Push(data)
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Package stack provides a generic LIFO stack built on the singly linked list
+The top of the stack is the Head of the list, so Push and Pop are O(1) front operations
+*/
+package stack
+
+import (
+	"errors"
+	"iter"
+
+	"generic/linkedlist"
+)
+
+// Sentinel errors returned by the stack operations
+var (
+	ErrEmpty = errors.New("stack: stack is empty")
+	ErrFull  = errors.New("stack: stack is full")
+)
+
+// Stack is a last in, first out collection, the zero value is an empty stack ready to use
+type Stack[T comparable] struct {
+	list linkedlist.LinkedList[T]
+}
+
+// Push puts data on top of the stack in O(1)
+func (stack *Stack[T]) Push(data T) {
+	stack.list.InsertAtFront(data)
+}
+
+// Pop removes the top of the stack in O(1) and returns it, or ErrEmpty
+func (stack *Stack[T]) Pop() (T, error) {
+	data, err := stack.list.PopFront()
+	if err != nil {
+		return data, ErrEmpty
+	}
+	return data, nil
+}
+
+// Peek returns the top of the stack without removing it, or ErrEmpty
+func (stack *Stack[T]) Peek() (T, error) {
+	if stack.list.Head == nil {
+		var zero T
+		return zero, ErrEmpty
+	}
+	return stack.list.Head.Data, nil
+}
+
+// Len returns the number of values on the stack
+func (stack *Stack[T]) Len() int {
+	return stack.list.Len()
+}
+
+// IsEmpty reports whether the stack holds no values
+func (stack *Stack[T]) IsEmpty() bool {
+	return stack.list.Len() == 0
+}
+
+// Values returns an iterator over the values from the top to the bottom of the stack
+func (stack *Stack[T]) Values() iter.Seq[T] {
+	return stack.list.Values()
+}
+
+/*
+BoundedStack is a Stack that holds at most a fixed number of values
+Push returns ErrFull instead of growing past the capacity
+*/
+type BoundedStack[T comparable] struct {
+	stack    Stack[T]
+	capacity int
+}
+
+// NewBounded returns an empty stack that holds at most capacity values
+func NewBounded[T comparable](capacity int) *BoundedStack[T] {
+	return &BoundedStack[T]{capacity: capacity}
+}
+
+// Push puts data on top of the stack, or returns ErrFull if the stack is at its capacity
+func (stack *BoundedStack[T]) Push(data T) error {
+	if stack.IsFull() {
+		return ErrFull
+	}
+
+	stack.stack.Push(data)
+	return nil
+}
+
+// Pop removes the top of the stack and returns it, or ErrEmpty
+func (stack *BoundedStack[T]) Pop() (T, error) {
+	return stack.stack.Pop()
+}
+
+// Peek returns the top of the stack without removing it, or ErrEmpty
+func (stack *BoundedStack[T]) Peek() (T, error) {
+	return stack.stack.Peek()
+}
+
+// Len returns the number of values on the stack
+func (stack *BoundedStack[T]) Len() int {
+	return stack.stack.Len()
+}
+
+// Cap returns the maximum number of values the stack can hold
+func (stack *BoundedStack[T]) Cap() int {
+	return stack.capacity
+}
+
+// IsEmpty reports whether the stack holds no values
+func (stack *BoundedStack[T]) IsEmpty() bool {
+	return stack.stack.IsEmpty()
+}
+
+// IsFull reports whether the stack is at its capacity
+func (stack *BoundedStack[T]) IsFull() bool {
+	return stack.stack.Len() >= stack.capacity
+}
+
+// Values returns an iterator over the values from the top to the bottom of the stack
+func (stack *BoundedStack[T]) Values() iter.Seq[T] {
+	return stack.stack.Values()
+}
@@ -0,0 +1,93 @@
+package stack_test
+
+import (
+	"errors"
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"stackqueue/stack"
+)
+
+// lifo is what Stack and BoundedStack have in common, Push of a Stack never fails
+type lifo interface {
+	Pop() (int, error)
+	Peek() (int, error)
+	Len() int
+	IsEmpty() bool
+	Values() iter.Seq[int]
+}
+
+// check fails t unless s holds want, listed from the bottom to the top
+func check(t *testing.T, s lifo, want []int) {
+	t.Helper()
+
+	top := slices.Clone(want)
+	slices.Reverse(top)
+	if got := slices.Collect(s.Values()); !slices.Equal(got, top) || s.Len() != len(want) || s.IsEmpty() != (len(want) == 0) {
+		t.Fatalf("got %v with Len %d, want %v from the top", got, s.Len(), top)
+	}
+	peek, err := s.Peek()
+	if len(want) == 0 && !errors.Is(err, stack.ErrEmpty) {
+		t.Fatalf("Peek of an empty stack returned %v", err)
+	}
+	if len(want) > 0 && (err != nil || peek != want[len(want)-1]) {
+		t.Fatalf("Peek returned %d, %v, want %d", peek, err, want[len(want)-1])
+	}
+}
+
+// run pushes and pops at random against a slice, push reports whether the stack took the value
+func run(t *testing.T, s lifo, push func(int) bool, capacity int) {
+	random := rand.New(rand.NewPCG(1, 2))
+	var want []int
+	for step := range 2000 {
+		if random.IntN(2) == 0 {
+			if pushed := push(step); pushed != (capacity == 0 || len(want) < capacity) {
+				t.Fatalf("step %d: Push with %d values took the value: %t", step, len(want), pushed)
+			}
+			if capacity == 0 || len(want) < capacity {
+				want = append(want, step)
+			}
+		} else {
+			got, err := s.Pop()
+			if len(want) == 0 {
+				if !errors.Is(err, stack.ErrEmpty) {
+					t.Fatalf("step %d: Pop of an empty stack returned %v", step, err)
+				}
+			} else if err != nil || got != want[len(want)-1] {
+				t.Fatalf("step %d: Pop returned %d, %v, want %d", step, got, err, want[len(want)-1])
+			} else {
+				want = want[:len(want)-1]
+			}
+		}
+		check(t, s, want)
+	}
+}
+
+func TestStack(t *testing.T) {
+	var s stack.Stack[int]
+	run(t, &s, func(v int) bool { s.Push(v); return true }, 0)
+}
+
+func TestBoundedStack(t *testing.T) {
+	s := stack.NewBounded[int](3)
+	run(t, s, func(v int) bool {
+		err := s.Push(v)
+		if err != nil && !errors.Is(err, stack.ErrFull) {
+			t.Fatalf("Push returned %v", err)
+		}
+		return err == nil
+	}, 3)
+
+	full := stack.NewBounded[int](2)
+	full.Push(1)
+	full.Push(2)
+	if !full.IsFull() || full.Cap() != 2 {
+		t.Fatalf("IsFull %t and Cap %d, want a full stack of 2", full.IsFull(), full.Cap())
+	}
+	if err := full.Push(3); !errors.Is(err, stack.ErrFull) {
+		t.Fatalf("Push on a full stack returned %v", err)
+	}
+	check(t, full, []int{1, 2})
+}
@@ -0,0 +1,490 @@
+package graph_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"graph/graph"
+)
+
+/*
+TestAlgorithms checks the path, spanning tree and flow algorithms against brute force on random graphs
+small enough to enumerate every simple path, every edge subset and every cut, with up to 6 vertices,
+with and without negative weights. It checks both the values and the structures returned: every path must
+follow real edges and add up to its weight, every cycle must be a cycle, every forest a forest and every
+flow must respect capacities and conservation
+*/
+func TestAlgorithms(t *testing.T) {
+	for _, c := range configs {
+		t.Run(c.name, func(t *testing.T) {
+			random := rand.New(rand.NewPCG(1, 2))
+			for i := range 100 {
+				n := 1 + random.IntN(6)
+				m := random.IntN(2*n + 2)
+				low := 0
+				if i%2 == 1 {
+					low = -3
+				}
+				g := randomGraph(random, c.config, n, m, low, 9)
+
+				t.Run(fmt.Sprintf("Graph%d", i), func(t *testing.T) {
+					checkShortestPaths(t, g)
+					checkAllPairs(t, g)
+					checkSpanningForest(t, g)
+					checkMaxFlow(t, g)
+				})
+			}
+		})
+	}
+}
+
+// bruteForce holds the facts about a small graph found by enumerating its simple paths
+type bruteForce struct {
+	// distance[s][v] is the weight of the lightest simple path from s to v, reached[s][v] whether there is one
+	distance [][]int
+	reached  [][]bool
+	// negative[s] reports whether a negative cycle is reachable from s
+	negative []bool
+}
+
+// enumerate walks every simple path of g from every vertex, also closing it into a cycle when it can
+func enumerate(g *graph.Graph[int, int]) bruteForce {
+	n := g.Order()
+	facts := bruteForce{distance: make([][]int, n), reached: make([][]bool, n), negative: make([]bool, n)}
+	onCycle := make([]bool, n)
+
+	for s := range n {
+		facts.distance[s] = make([]int, n)
+		facts.reached[s] = make([]bool, n)
+		onPath := make([]bool, n)
+
+		var walk func(v, weight int)
+		walk = func(v, weight int) {
+			if !facts.reached[s][v] || weight < facts.distance[s][v] {
+				facts.distance[s][v], facts.reached[s][v] = weight, true
+			}
+			onPath[v] = true
+			for w, edge := range g.Neighbors(v) {
+				if w == s && weight+edge < 0 {
+					onCycle[s] = true
+				}
+				if !onPath[w] {
+					walk(w, weight+edge)
+				}
+			}
+			onPath[v] = false
+		}
+		walk(s, 0)
+	}
+
+	for s := range n {
+		for v := range n {
+			if facts.reached[s][v] && onCycle[v] {
+				facts.negative[s] = true
+			}
+		}
+	}
+	return facts
+}
+
+// checkPath fails t if path does not run from from to to over edges of g with the given total weight
+func checkPath(t testing.TB, name string, g *graph.Graph[int, int], path graph.Path[int, int], from, to, weight int) {
+	t.Helper()
+
+	vertices := path.Vertices
+	if len(vertices) == 0 || vertices[0] != from || vertices[len(vertices)-1] != to {
+		t.Fatalf("%s: path %v does not run from %d to %d", name, vertices, from, to)
+	}
+
+	total := 0
+	for i := 1; i < len(vertices); i++ {
+		edge, ok := g.Edge(vertices[i-1], vertices[i])
+		if !ok {
+			t.Fatalf("%s: path %v uses the missing edge %d -> %d", name, vertices, vertices[i-1], vertices[i])
+		}
+		total += edge
+	}
+	if total != weight || path.Weight != weight {
+		t.Fatalf("%s: path %v weighs %d and says %d, want %d", name, vertices, total, path.Weight, weight)
+	}
+}
+
+// checkNegativeCycle fails t if err is not a *graph.NegativeCycleError holding a negative cycle of g
+func checkNegativeCycle(t testing.TB, name string, g *graph.Graph[int, int], err error) {
+	t.Helper()
+
+	var cycleErr *graph.NegativeCycleError[int]
+	if !errors.As(err, &cycleErr) || !errors.Is(err, graph.ErrNegativeCycle) {
+		t.Fatalf("%s: got error %v, want a negative cycle", name, err)
+	}
+
+	cycle := cycleErr.Cycle
+	total := 0
+	for i, v := range cycle {
+		edge, ok := g.Edge(v, cycle[(i+1)%len(cycle)])
+		if !ok {
+			t.Fatalf("%s: cycle %v uses a missing edge", name, cycle)
+		}
+		total += edge
+	}
+	if len(cycle) == 0 || total >= 0 {
+		t.Fatalf("%s: cycle %v weighs %d, want a negative weight", name, cycle, total)
+	}
+}
+
+/*
+checkShortestPaths runs the single source algorithms from every vertex of g and compares them with brute force:
+BellmanFord always, Dijkstra, DijkstraPairing and AStar (with a zero, an exact and a halved heuristic) when no weight is negative
+*/
+func checkShortestPaths(t testing.TB, g *graph.Graph[int, int]) {
+	t.Helper()
+
+	facts := enumerate(g)
+	negativeWeight := false
+	for edge := range g.Edges() {
+		negativeWeight = negativeWeight || edge.Weight < 0
+	}
+
+	for s := range g.Order() {
+		paths, err := g.BellmanFord(s)
+		if facts.negative[s] {
+			checkNegativeCycle(t, fmt.Sprintf("BellmanFord(%d)", s), g, err)
+		} else if err != nil {
+			t.Fatalf("BellmanFord(%d): %v", s, err)
+		} else {
+			checkDistances(t, fmt.Sprintf("BellmanFord(%d)", s), g, facts, s, paths)
+		}
+
+		single := map[string]func(int) (*graph.ShortestPaths[int, int], error){
+			"Dijkstra":        g.Dijkstra,
+			"DijkstraPairing": g.DijkstraPairing,
+		}
+		for name, algorithm := range single {
+			paths, err := algorithm(s)
+			if negativeWeight {
+				if !errors.Is(err, graph.ErrNegativeWeight) {
+					t.Fatalf("%s(%d) with a negative weight: got error %v, want %v", name, s, err, graph.ErrNegativeWeight)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%s(%d): %v", name, s, err)
+			}
+			checkDistances(t, fmt.Sprintf("%s(%d)", name, s), g, facts, s, paths)
+		}
+
+		if negativeWeight {
+			continue
+		}
+		for target := range g.Order() {
+			heuristics := map[string]func(int) int{
+				"zero":  graph.ZeroHeuristic[int, int],
+				"exact": exactHeuristic(facts, target),
+				"half":  func(v int) int { return exactHeuristic(facts, target)(v) / 2 },
+			}
+			for name, heuristic := range heuristics {
+				path, err := g.AStar(s, target, heuristic)
+				if !facts.reached[s][target] {
+					if !errors.Is(err, graph.ErrNoPath) {
+						t.Fatalf("AStar(%d, %d) with the %s heuristic: got error %v, want %v", s, target, name, err, graph.ErrNoPath)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("AStar(%d, %d) with the %s heuristic: %v", s, target, name, err)
+				}
+				checkPath(t, fmt.Sprintf("AStar(%d, %d) with the %s heuristic", s, target, name), g, path, s, target, facts.distance[s][target])
+			}
+		}
+	}
+}
+
+// exactHeuristic returns the true distance to target, the best admissible heuristic there is
+func exactHeuristic(facts bruteForce, target int) func(int) int {
+	return func(v int) int {
+		if !facts.reached[v][target] {
+			return 0
+		}
+		return facts.distance[v][target]
+	}
+}
+
+// checkDistances fails t unless paths from s match the brute force distances and follow real edges
+func checkDistances(t testing.TB, name string, g *graph.Graph[int, int], facts bruteForce, s int, paths *graph.ShortestPaths[int, int]) {
+	t.Helper()
+
+	for v := range g.Order() {
+		distance, reached := paths.Distance(v)
+		path, ok := paths.To(v)
+		if reached != facts.reached[s][v] || ok != reached {
+			t.Fatalf("%s: reaches %d: %t, want %t", name, v, reached, facts.reached[s][v])
+		}
+		if reached {
+			if distance != facts.distance[s][v] {
+				t.Fatalf("%s: distance to %d is %d, want %d", name, v, distance, facts.distance[s][v])
+			}
+			checkPath(t, fmt.Sprintf("%s to %d", name, v), g, path, s, v, distance)
+		}
+	}
+}
+
+// checkAllPairs runs FloydWarshall and Johnson on g and compares every path with brute force
+func checkAllPairs(t testing.TB, g *graph.Graph[int, int]) {
+	t.Helper()
+
+	facts := enumerate(g)
+	negative := slices.Contains(facts.negative, true)
+	algorithms := map[string]func() (*graph.AllPairs[int, int], error){
+		"FloydWarshall": g.FloydWarshall,
+		"Johnson":       g.Johnson,
+	}
+
+	for name, algorithm := range algorithms {
+		pairs, err := algorithm()
+		if negative {
+			checkNegativeCycle(t, name, g, err)
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		for s := range g.Order() {
+			for v := range g.Order() {
+				distance, reached := pairs.Distance(s, v)
+				path, ok := pairs.Path(s, v)
+				if reached != facts.reached[s][v] || ok != reached {
+					t.Fatalf("%s: %d reaches %d: %t, want %t", name, s, v, reached, facts.reached[s][v])
+				}
+				if reached {
+					if distance != facts.distance[s][v] {
+						t.Fatalf("%s: distance from %d to %d is %d, want %d", name, s, v, distance, facts.distance[s][v])
+					}
+					checkPath(t, fmt.Sprintf("%s from %d to %d", name, s, v), g, path, s, v, distance)
+				}
+			}
+		}
+	}
+}
+
+/*
+checkSpanningForest runs Kruskal and Prim on g and compares them with the lightest spanning forest
+found by trying every subset of the edges. A directed graph must return graph.ErrDirected
+*/
+func checkSpanningForest(t testing.TB, g *graph.Graph[int, int]) {
+	t.Helper()
+
+	algorithms := map[string]func() (graph.SpanningForest[int, int], error){
+		"Kruskal": g.Kruskal,
+		"Prim":    g.Prim,
+	}
+	if g.Directed() {
+		for name, algorithm := range algorithms {
+			if _, err := algorithm(); !errors.Is(err, graph.ErrDirected) {
+				t.Fatalf("%s on a directed graph: got error %v, want %v", name, err, graph.ErrDirected)
+			}
+		}
+		return
+	}
+
+	var edges []graph.Edge[int, int]
+	for edge := range g.Edges() {
+		if edge.From != edge.To {
+			edges = append(edges, edge)
+		}
+	}
+	components := len(g.ConnectedComponents())
+	wantSize := g.Order() - components
+
+	best, found := 0, false
+	for subset := 0; subset < 1<<len(edges); subset++ {
+		var chosen []graph.Edge[int, int]
+		weight := 0
+		for i, edge := range edges {
+			if subset>>i&1 == 1 {
+				chosen = append(chosen, edge)
+				weight += edge.Weight
+			}
+		}
+		if len(chosen) == wantSize && isForest(g.Order(), chosen) && (!found || weight < best) {
+			best, found = weight, true
+		}
+	}
+
+	for name, algorithm := range algorithms {
+		forest, err := algorithm()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(forest.Edges) != wantSize || !isForest(g.Order(), forest.Edges) {
+			t.Fatalf("%s: %v is not a spanning forest of %d components", name, forest.Edges, components)
+		}
+
+		total := 0
+		for _, edge := range forest.Edges {
+			if weight, ok := g.Edge(edge.From, edge.To); !ok || weight != edge.Weight {
+				t.Fatalf("%s: edge %v is not in the graph", name, edge)
+			}
+			total += edge.Weight
+		}
+		if total != best || forest.Weight != best {
+			t.Fatalf("%s: forest weighs %d and says %d, want %d", name, total, forest.Weight, best)
+		}
+	}
+}
+
+// isForest reports whether edges have no cycle
+func isForest(n int, edges []graph.Edge[int, int]) bool {
+	root := make([]int, n)
+	for v := range root {
+		root[v] = v
+	}
+	find := func(v int) int {
+		for root[v] != v {
+			v = root[v]
+		}
+		return v
+	}
+
+	for _, edge := range edges {
+		a, b := find(edge.From), find(edge.To)
+		if a == b {
+			return false
+		}
+		root[a] = b
+	}
+	return true
+}
+
+/*
+checkMaxFlow runs EdmondsKarp and Dinic between every pair of vertices and compares the value with the
+minimum cut found by trying every set of vertices. It also checks that the edges respect their capacities
+and conservation, that the paths add up to the value, and that the cut holds the source and not the sink
+Graphs with a negative weight must return graph.ErrNegativeWeight
+*/
+func checkMaxFlow(t testing.TB, g *graph.Graph[int, int]) {
+	t.Helper()
+
+	n := g.Order()
+	negative := false
+	for edge := range g.Edges() {
+		negative = negative || edge.Weight < 0
+	}
+
+	algorithms := map[string]func(s, t int) (graph.Flow[int, int], error){
+		"EdmondsKarp": g.EdmondsKarp,
+		"Dinic":       g.Dinic,
+	}
+	for name, algorithm := range algorithms {
+		if _, err := algorithm(0, 0); !errors.Is(err, graph.ErrSourceIsSink) {
+			t.Fatalf("%s(0, 0): got error %v, want %v", name, err, graph.ErrSourceIsSink)
+		}
+
+		for s := range n {
+			for sink := range n {
+				if s == sink {
+					continue
+				}
+
+				flow, err := algorithm(s, sink)
+				if negative {
+					if !errors.Is(err, graph.ErrNegativeWeight) {
+						t.Fatalf("%s(%d, %d) with a negative weight: got error %v, want %v", name, s, sink, err, graph.ErrNegativeWeight)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("%s(%d, %d): %v", name, s, sink, err)
+				}
+				checkFlow(t, fmt.Sprintf("%s(%d, %d)", name, s, sink), g, flow, s, sink)
+			}
+		}
+	}
+}
+
+func checkFlow(t testing.TB, name string, g *graph.Graph[int, int], flow graph.Flow[int, int], s, sink int) {
+	t.Helper()
+
+	n := g.Order()
+	cutWeight := func(side []bool) int {
+		total := 0
+		for edge := range g.Edges() {
+			if edge.From == edge.To {
+				continue
+			}
+			if side[edge.From] && !side[edge.To] {
+				total += edge.Weight
+			}
+			if !g.Directed() && side[edge.To] && !side[edge.From] {
+				total += edge.Weight
+			}
+		}
+		return total
+	}
+
+	best := -1
+	for set := 0; set < 1<<n; set++ {
+		if set>>s&1 == 0 || set>>sink&1 == 1 {
+			continue
+		}
+		side := make([]bool, n)
+		for v := range n {
+			side[v] = set>>v&1 == 1
+		}
+		if weight := cutWeight(side); best == -1 || weight < best {
+			best = weight
+		}
+	}
+	if flow.Value != best {
+		t.Fatalf("%s: flow value %d, want the minimum cut %d", name, flow.Value, best)
+	}
+
+	balance := make([]int, n)
+	for _, edge := range flow.Edges {
+		capacity, ok := g.Edge(edge.From, edge.To)
+		if !ok || edge.Weight <= 0 || edge.Weight > capacity {
+			t.Fatalf("%s: edge %v does not fit capacity %d", name, edge, capacity)
+		}
+		balance[edge.From] -= edge.Weight
+		balance[edge.To] += edge.Weight
+	}
+	for v := range n {
+		want := 0
+		switch v {
+		case s:
+			want = -flow.Value
+		case sink:
+			want = flow.Value
+		}
+		if balance[v] != want {
+			t.Fatalf("%s: %d has a balance of %d, want %d in %v", name, v, balance[v], want, flow.Edges)
+		}
+	}
+
+	total := 0
+	for _, path := range flow.Paths {
+		for i := 1; i < len(path.Vertices); i++ {
+			if _, ok := g.Edge(path.Vertices[i-1], path.Vertices[i]); !ok {
+				t.Fatalf("%s: path %v uses a missing edge", name, path.Vertices)
+			}
+		}
+		if path.Vertices[0] != s || path.Vertices[len(path.Vertices)-1] != sink || path.Weight <= 0 {
+			t.Fatalf("%s: path %v carrying %d is not a flow path", name, path.Vertices, path.Weight)
+		}
+		total += path.Weight
+	}
+	if total != flow.Value {
+		t.Fatalf("%s: paths carry %d, want %d", name, total, flow.Value)
+	}
+
+	side := make([]bool, n)
+	for _, v := range flow.Cut {
+		side[v] = true
+	}
+	if !side[s] || side[sink] || cutWeight(side) != flow.Value {
+		t.Fatalf("%s: cut %v is not a minimum cut of weight %d", name, flow.Cut, flow.Value)
+	}
+}
@@ -0,0 +1,116 @@
+package graph
+
+/*
+AllPairs holds the shortest path between every pair of vertices, as computed by FloydWarshall or Johnson
+It stores a V x V matrix of distances and of previous vertices, paths are rebuilt in O(length) when asked for
+*/
+type AllPairs[V comparable, W Number] struct {
+	graph *Graph[V, W]
+	trees []tree[W]
+}
+
+// Distance returns the weight of the shortest path from from to to and whether to is reachable from from
+func (pairs *AllPairs[V, W]) Distance(from, to V) (W, bool) {
+	a, okFrom := pairs.graph.ids[from]
+	b, okTo := pairs.graph.ids[to]
+	if !okFrom || !okTo || !pairs.trees[a].reached[b] {
+		var zero W
+		return zero, false
+	}
+	return pairs.trees[a].distance[b], true
+}
+
+// Path returns the shortest path from from to to and whether to is reachable from from
+func (pairs *AllPairs[V, W]) Path(from, to V) (Path[V, W], bool) {
+	a, okFrom := pairs.graph.ids[from]
+	b, okTo := pairs.graph.ids[to]
+	if !okFrom || !okTo || !pairs.trees[a].reached[b] {
+		return Path[V, W]{}, false
+	}
+	t := pairs.trees[a]
+	return Path[V, W]{Vertices: pairs.graph.values(t.path(b)), Weight: t.distance[b]}, true
+}
+
+/*
+FloydWarshall returns the shortest paths between every pair of vertices, negative weights are allowed
+After round k the distance from i to j is the shortest using only the first k vertices in between,
+so round k only has to check whether going through vertex k is shorter. O(V^3) time and O(V^2) memory,
+which beats running Bellman-Ford from every vertex and is simple enough to win on small dense graphs
+A negative distance from a vertex to itself means a negative cycle, which Bellman-Ford from that vertex
+returns in a *NegativeCycleError
+*/
+func (graph *Graph[V, W]) FloydWarshall() (*AllPairs[V, W], error) {
+	n := len(graph.vertices)
+	trees := make([]tree[W], n)
+	for i := range trees {
+		trees[i] = newTree[W](n)
+		trees[i].reached[i] = true
+		for j, weight := range graph.backing.arcs(i) {
+			if i != j || weight < 0 {
+				trees[i].distance[j], trees[i].previous[j], trees[i].reached[j] = weight, i, true
+			}
+		}
+	}
+
+	for k := range n {
+		through := trees[k]
+		for i := range n {
+			from := trees[i]
+			if !from.reached[k] {
+				continue
+			}
+			for j := range n {
+				if !through.reached[j] {
+					continue
+				}
+				if candidate := from.distance[k] + through.distance[j]; !from.reached[j] || candidate < from.distance[j] {
+					from.distance[j], from.previous[j], from.reached[j] = candidate, through.previous[j], true
+				}
+			}
+		}
+	}
+
+	for i := range n {
+		if trees[i].distance[i] < 0 {
+			_, cycle := graph.bellmanFord([]int{i})
+			return nil, &NegativeCycleError[V]{Cycle: graph.values(cycle)}
+		}
+	}
+	return &AllPairs[V, W]{graph: graph, trees: trees}, nil
+}
+
+/*
+Johnson returns the shortest paths between every pair of vertices, negative weights are allowed
+It runs Bellman-Ford once from a virtual vertex with a 0 edge to every vertex to get a potential h(v),
+then reweights every edge to weight + h(from) - h(to), which is never negative but keeps shortest paths shortest,
+and runs Dijkstra from every vertex. O(V E log V), which beats FloydWarshall on sparse graphs
+A negative cycle is returned in a *NegativeCycleError
+*/
+func (graph *Graph[V, W]) Johnson() (*AllPairs[V, W], error) {
+	n := len(graph.vertices)
+	all := make([]int, n)
+	for v := range all {
+		all[v] = v
+	}
+
+	potential, cycle := graph.bellmanFord(all)
+	if cycle != nil {
+		return nil, &NegativeCycleError[V]{Cycle: graph.values(cycle)}
+	}
+	h := potential.distance
+	reweight := func(from, to int, weight W) W {
+		return weight + h[from] - h[to]
+	}
+
+	trees := make([]tree[W], n)
+	for s := range n {
+		t := graph.dijkstra(s, newPairingHeap[W](n), reweight)
+		for v := range n {
+			if t.reached[v] {
+				t.distance[v] = t.distance[v] - h[s] + h[v]
+			}
+		}
+		trees[s] = t
+	}
+	return &AllPairs[V, W]{graph: graph, trees: trees}, nil
+}
@@ -0,0 +1,127 @@
+package graph
+
+import "iter"
+
+/*
+backing stores the edges between vertex ids 0 to n-1, the Graph maps its vertices to those ids
+An undirected edge is stored as two arcs, one in each direction, the Graph takes care of that
+*/
+type backing[W Number] interface {
+	addVertex()
+	// setArc adds or replaces the arc from -> to and reports whether it is new
+	setArc(from, to int, weight W) bool
+	// removeArc removes the arc from -> to and reports whether it existed
+	removeArc(from, to int) bool
+	arc(from, to int) (W, bool)
+	arcs(from int) iter.Seq2[int, W]
+}
+
+// arc is an outgoing edge in an adjacency list
+type arc[W Number] struct {
+	to     int
+	weight W
+}
+
+/*
+adjacencyList keeps a slice of outgoing arcs per vertex
+It uses O(V + E) memory and lists the neighbors of a vertex in O(degree), but looking up one edge is O(degree) too
+It is the right choice for sparse graphs, which most graphs are
+*/
+type adjacencyList[W Number] struct {
+	out [][]arc[W]
+}
+
+func (list *adjacencyList[W]) addVertex() {
+	list.out = append(list.out, nil)
+}
+
+func (list *adjacencyList[W]) setArc(from, to int, weight W) bool {
+	for i, a := range list.out[from] {
+		if a.to == to {
+			list.out[from][i].weight = weight
+			return false
+		}
+	}
+	list.out[from] = append(list.out[from], arc[W]{to: to, weight: weight})
+	return true
+}
+
+func (list *adjacencyList[W]) removeArc(from, to int) bool {
+	for i, a := range list.out[from] {
+		if a.to == to {
+			list.out[from] = append(list.out[from][:i], list.out[from][i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (list *adjacencyList[W]) arc(from, to int) (W, bool) {
+	for _, a := range list.out[from] {
+		if a.to == to {
+			return a.weight, true
+		}
+	}
+	var zero W
+	return zero, false
+}
+
+func (list *adjacencyList[W]) arcs(from int) iter.Seq2[int, W] {
+	return func(yield func(int, W) bool) {
+		for _, a := range list.out[from] {
+			if !yield(a.to, a.weight) {
+				return
+			}
+		}
+	}
+}
+
+// cell is one entry of an adjacency matrix
+type cell[W Number] struct {
+	weight  W
+	present bool
+}
+
+/*
+adjacencyMatrix keeps a V x V matrix of cells
+Looking up, adding and removing an edge is O(1), but it uses O(V^2) memory, listing neighbors is O(V)
+and adding a vertex copies the matrix. It is the right choice for small or dense graphs
+*/
+type adjacencyMatrix[W Number] struct {
+	cells [][]cell[W]
+}
+
+func (matrix *adjacencyMatrix[W]) addVertex() {
+	n := len(matrix.cells) + 1
+	for i := range matrix.cells {
+		matrix.cells[i] = append(matrix.cells[i], cell[W]{})
+	}
+	matrix.cells = append(matrix.cells, make([]cell[W], n))
+}
+
+func (matrix *adjacencyMatrix[W]) setArc(from, to int, weight W) bool {
+	isNew := !matrix.cells[from][to].present
+	matrix.cells[from][to] = cell[W]{weight: weight, present: true}
+	return isNew
+}
+
+func (matrix *adjacencyMatrix[W]) removeArc(from, to int) bool {
+	existed := matrix.cells[from][to].present
+	matrix.cells[from][to] = cell[W]{}
+	return existed
+}
+
+func (matrix *adjacencyMatrix[W]) arc(from, to int) (W, bool) {
+	c := matrix.cells[from][to]
+	return c.weight, c.present
+}
+
+func (matrix *adjacencyMatrix[W]) arcs(from int) iter.Seq2[int, W] {
+	return func(yield func(int, W) bool) {
+		for to, c := range matrix.cells[from] {
+			if c.present && !yield(to, c.weight) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package graph
+
+import (
+	"slices"
+
+	"stackqueue/queue"
+)
+
+/*
+ConnectedComponents returns the vertices grouped by connected component, ignoring edge directions
+(so for a directed graph these are the weakly connected components)
+Components are ordered by their first vertex and list their vertices in the order they were added, O(V + E)
+*/
+func (graph *Graph[V, W]) ConnectedComponents() [][]V {
+	links := graph.links()
+	component := make([]int, len(graph.vertices))
+	for v := range component {
+		component[v] = -1
+	}
+
+	count := 0
+	for first := range graph.vertices {
+		if component[first] != -1 {
+			continue
+		}
+
+		component[first] = count
+		var pending queue.Queue[int]
+		pending.Enqueue(first)
+		for !pending.IsEmpty() {
+			v, _ := pending.Dequeue()
+			for _, l := range links[v] {
+				if component[l.to] == -1 {
+					component[l.to] = count
+					pending.Enqueue(l.to)
+				}
+			}
+		}
+		count++
+	}
+
+	return graph.group(component, count)
+}
+
+/*
+StronglyConnectedTarjan returns the strongly connected components, the largest sets of vertices that can all reach each other
+Tarjan's algorithm does one DFS and keeps the visited vertices on a stack: low[v] is the earliest visited vertex
+still on the stack that v can reach, and a vertex whose low is itself is the root of a component, which is popped off
+Components come out in reverse topological order (a component only has edges to components before it)
+and list their vertices in the order they were added, O(V + E)
+*/
+func (graph *Graph[V, W]) StronglyConnectedTarjan() [][]V {
+	n := len(graph.vertices)
+	order := make([]int, n) // 1 based visiting order, 0 is unvisited
+	low := make([]int, n)
+	onStack := make([]bool, n)
+	var visiting []int
+	var components [][]V
+	counter := 0
+
+	var visit func(v int)
+	visit = func(v int) {
+		counter++
+		order[v], low[v] = counter, counter
+		visiting = append(visiting, v)
+		onStack[v] = true
+
+		for w := range graph.backing.arcs(v) {
+			if order[w] == 0 {
+				visit(w)
+				low[v] = min(low[v], low[w])
+			} else if onStack[w] {
+				low[v] = min(low[v], order[w])
+			}
+		}
+
+		if low[v] == order[v] {
+			root := slices.Index(visiting, v)
+			component := visiting[root:]
+			for _, w := range component {
+				onStack[w] = false
+			}
+			slices.Sort(component)
+			components = append(components, graph.values(component))
+			visiting = visiting[:root]
+		}
+	}
+
+	for v := range graph.vertices {
+		if order[v] == 0 {
+			visit(v)
+		}
+	}
+	return components
+}
+
+/*
+StronglyConnectedKosaraju returns the strongly connected components, like StronglyConnectedTarjan
+Kosaraju's algorithm does two DFS passes: the first records the order in which vertices finish,
+the second walks the reversed edges from the vertices in reverse finishing order, and every walk stays inside one component
+Components come out in topological order (a component only has edges to components after it), O(V + E)
+*/
+func (graph *Graph[V, W]) StronglyConnectedKosaraju() [][]V {
+	n := len(graph.vertices)
+	visited := make([]bool, n)
+	finished := make([]int, 0, n)
+
+	var visit func(v int)
+	visit = func(v int) {
+		visited[v] = true
+		for w := range graph.backing.arcs(v) {
+			if !visited[w] {
+				visit(w)
+			}
+		}
+		finished = append(finished, v)
+	}
+	for v := range graph.vertices {
+		if !visited[v] {
+			visit(v)
+		}
+	}
+
+	in := graph.predecessors()
+	component := make([]int, n)
+	for v := range component {
+		component[v] = -1
+	}
+
+	var collect func(v, count int)
+	collect = func(v, count int) {
+		component[v] = count
+		for _, u := range in[v] {
+			if component[u] == -1 {
+				collect(u, count)
+			}
+		}
+	}
+
+	count := 0
+	for i := n - 1; i >= 0; i-- {
+		if v := finished[i]; component[v] == -1 {
+			collect(v, count)
+			count++
+		}
+	}
+	return graph.group(component, count)
+}
+
+/*
+Bipartite splits the vertices into two sides so every edge goes between the sides and reports whether that is possible
+It colors every component by BFS, alternating sides, and fails on an edge between two vertices of the same color,
+which means the graph has an odd cycle. Edge directions are ignored, O(V + E)
+*/
+func (graph *Graph[V, W]) Bipartite() (left, right []V, ok bool) {
+	links := graph.links()
+	side := make([]int, len(graph.vertices))
+	for first := range graph.vertices {
+		if side[first] != 0 {
+			continue
+		}
+
+		side[first] = 1
+		var pending queue.Queue[int]
+		pending.Enqueue(first)
+		for !pending.IsEmpty() {
+			v, _ := pending.Dequeue()
+			for _, l := range links[v] {
+				switch side[l.to] {
+				case 0:
+					side[l.to] = -side[v]
+					pending.Enqueue(l.to)
+				case side[v]:
+					return nil, nil, false
+				}
+			}
+		}
+	}
+
+	for v, s := range side {
+		if s == 1 {
+			left = append(left, graph.vertices[v])
+		} else {
+			right = append(right, graph.vertices[v])
+		}
+	}
+	return left, right, true
+}
+
+// Bridges returns the edges whose removal disconnects their component, see cuts
+func (graph *Graph[V, W]) Bridges() []Edge[V, W] {
+	bridges, _ := graph.cuts()
+	edges := slices.Collect(graph.Edges())
+
+	result := make([]Edge[V, W], len(bridges))
+	for i, edge := range bridges {
+		result[i] = edges[edge]
+	}
+	return result
+}
+
+// ArticulationPoints returns the vertices whose removal disconnects their component, see cuts
+func (graph *Graph[V, W]) ArticulationPoints() []V {
+	_, points := graph.cuts()
+	return graph.values(points)
+}
+
+/*
+cuts returns the edge ids of the bridges and the vertex ids of the articulation points, edge directions are ignored
+It is Tarjan's DFS with low[v], the earliest visited vertex v's subtree reaches over one edge other than the one it came in by
+The edge to a child w is a bridge if low[w] is after v, because nothing in w's subtree reaches back past it,
+and v is an articulation point if low[w] is not before v for some child w (the root needs two children instead)
+Two edges between the same vertices, like a -> b and b -> a, never form a bridge, O(V + E)
+*/
+func (graph *Graph[V, W]) cuts() (bridges, points []int) {
+	links := graph.links()
+	n := len(graph.vertices)
+	order := make([]int, n) // 1 based visiting order, 0 is unvisited
+	low := make([]int, n)
+	isPoint := make([]bool, n)
+	counter := 0
+
+	var visit func(v, parentEdge int)
+	visit = func(v, parentEdge int) {
+		counter++
+		order[v], low[v] = counter, counter
+		children := 0
+
+		for _, l := range links[v] {
+			switch {
+			case l.edge == parentEdge:
+			case order[l.to] == 0:
+				children++
+				visit(l.to, l.edge)
+				low[v] = min(low[v], low[l.to])
+				if low[l.to] > order[v] {
+					bridges = append(bridges, l.edge)
+				}
+				if parentEdge != -1 && low[l.to] >= order[v] {
+					isPoint[v] = true
+				}
+			default:
+				low[v] = min(low[v], order[l.to])
+			}
+		}
+
+		if parentEdge == -1 && children > 1 {
+			isPoint[v] = true
+		}
+	}
+
+	for v := range graph.vertices {
+		if order[v] == 0 {
+			visit(v, -1)
+		}
+	}
+
+	slices.Sort(bridges)
+	for v, point := range isPoint {
+		if point {
+			points = append(points, v)
+		}
+	}
+	return bridges, points
+}
+
+// group returns the vertices grouped by their component number, in the order they were added
+func (graph *Graph[V, W]) group(component []int, count int) [][]V {
+	groups := make([][]V, count)
+	for v, c := range component {
+		groups[c] = append(groups[c], graph.vertices[v])
+	}
+	return groups
+}
@@ -0,0 +1,199 @@
+package graph_test
+
+import (
+	"slices"
+	"testing"
+
+	"graph/graph"
+)
+
+// componentOf returns the index of the component every vertex is in and fails t unless components split the vertices of g
+func componentOf(t *testing.T, name string, g *graph.Graph[int, int], components [][]int) []int {
+	t.Helper()
+
+	of := make([]int, g.Order())
+	for v := range of {
+		of[v] = -1
+	}
+	for i, component := range components {
+		if len(component) == 0 || !slices.IsSorted(component) {
+			t.Fatalf("%s: component %v is empty or not in the order the vertices were added", name, component)
+		}
+		for _, v := range component {
+			if of[v] != -1 {
+				t.Fatalf("%s: %d is in two components of %v", name, v, components)
+			}
+			of[v] = i
+		}
+	}
+	if slices.Contains(of, -1) {
+		t.Fatalf("%s: components %v miss a vertex", name, components)
+	}
+	return of
+}
+
+// TestComponents checks the connected and the strongly connected components of random graphs against reachability
+func TestComponents(t *testing.T) {
+	randomGraphs(t, func(t *testing.T, g *graph.Graph[int, int]) {
+		weakly, strongly := reachable(g, false), reachable(g, true)
+
+		connected := g.ConnectedComponents()
+		of := componentOf(t, "ConnectedComponents", g, connected)
+		for v := range of {
+			for w := range of {
+				if (of[v] == of[w]) != weakly[v][w] {
+					t.Fatalf("ConnectedComponents %v: %d and %d are connected: %t", connected, v, w, weakly[v][w])
+				}
+			}
+		}
+		for i := 1; i < len(connected); i++ {
+			if connected[i-1][0] > connected[i][0] {
+				t.Fatalf("ConnectedComponents %v are not ordered by their first vertex", connected)
+			}
+		}
+
+		for name, components := range map[string][][]int{
+			"Tarjan":   g.StronglyConnectedTarjan(),
+			"Kosaraju": g.StronglyConnectedKosaraju(),
+		} {
+			of := componentOf(t, name, g, components)
+			for v := range of {
+				for w := range of {
+					if (of[v] == of[w]) != (strongly[v][w] && strongly[w][v]) {
+						t.Fatalf("%s %v: %d and %d reach each other: %t", name, components, v, w, strongly[v][w] && strongly[w][v])
+					}
+				}
+			}
+
+			// Kosaraju lists the components in topological order and Tarjan in reverse topological order
+			for edge := range g.Edges() {
+				from, to := of[edge.From], of[edge.To]
+				if name == "Tarjan" {
+					from, to = to, from
+				}
+				if g.Directed() && from > to {
+					t.Fatalf("%s %v: the edge %d -> %d goes against the order of the components", name, components, edge.From, edge.To)
+				}
+			}
+		}
+	})
+}
+
+// TestBipartite checks Bipartite on random graphs against trying every split of the vertices
+func TestBipartite(t *testing.T) {
+	randomGraphs(t, func(t *testing.T, g *graph.Graph[int, int]) {
+		n := g.Order()
+		possible := false
+		for split := 0; split < 1<<n && !possible; split++ {
+			possible = true
+			for edge := range g.Edges() {
+				if split>>edge.From&1 == split>>edge.To&1 {
+					possible = false
+					break
+				}
+			}
+		}
+
+		left, right, ok := g.Bipartite()
+		if ok != possible {
+			t.Fatalf("Bipartite returned %t, want %t", ok, possible)
+		}
+		if !ok {
+			if left != nil || right != nil {
+				t.Fatalf("Bipartite returned sides %v and %v for a graph with an odd cycle", left, right)
+			}
+			return
+		}
+		if len(left)+len(right) != n || !slices.IsSorted(left) || !slices.IsSorted(right) {
+			t.Fatalf("sides %v and %v do not list the %d vertices in order", left, right, n)
+		}
+		for edge := range g.Edges() {
+			if slices.Contains(left, edge.From) == slices.Contains(left, edge.To) {
+				t.Fatalf("the edge %d -> %d stays on one side of %v and %v", edge.From, edge.To, left, right)
+			}
+		}
+	})
+}
+
+// countComponents counts the connected components of the vertices of g that are not skipped, over the edges that are not skipped
+func countComponents(g *graph.Graph[int, int], skipVertex, skipEdge int) int {
+	parent := make([]int, g.Order())
+	for v := range parent {
+		parent[v] = v
+	}
+	var find func(v int) int
+	find = func(v int) int {
+		if parent[v] != v {
+			parent[v] = find(parent[v])
+		}
+		return parent[v]
+	}
+
+	count := g.Order()
+	if skipVertex >= 0 {
+		count--
+	}
+	i := 0
+	for edge := range g.Edges() {
+		if i != skipEdge && edge.From != skipVertex && edge.To != skipVertex {
+			if a, b := find(edge.From), find(edge.To); a != b {
+				parent[a] = b
+				count--
+			}
+		}
+		i++
+	}
+	return count
+}
+
+// TestCuts checks Bridges and ArticulationPoints on random graphs by removing every edge and every vertex in turn
+func TestCuts(t *testing.T) {
+	randomGraphs(t, func(t *testing.T, g *graph.Graph[int, int]) {
+		components := countComponents(g, -1, -1)
+
+		var bridges []graph.Edge[int, int]
+		i := 0
+		for edge := range g.Edges() {
+			if countComponents(g, -1, i) > components {
+				bridges = append(bridges, edge)
+			}
+			i++
+		}
+		if got := g.Bridges(); !slices.Equal(got, bridges) {
+			t.Fatalf("Bridges = %v, want %v", got, bridges)
+		}
+
+		var points []int
+		for v := range g.Vertices() {
+			if countComponents(g, v, -1) > components {
+				points = append(points, v)
+			}
+		}
+		if got := g.ArticulationPoints(); !slices.Equal(got, points) {
+			t.Fatalf("ArticulationPoints = %v, want %v", got, points)
+		}
+	})
+}
+
+// TestCutsFixture checks the two triangles joined by one link of main.go, where the link is the only bridge
+func TestCutsFixture(t *testing.T) {
+	g := graph.New[string, int](graph.Config{})
+	for _, edge := range [][2]string{{"a", "b"}, {"b", "c"}, {"c", "a"}, {"c", "d"}, {"d", "e"}, {"e", "f"}, {"f", "d"}} {
+		g.AddEdge(edge[0], edge[1], 1)
+	}
+
+	if got := g.Bridges(); len(got) != 1 || got[0].From != "c" || got[0].To != "d" {
+		t.Fatalf("Bridges = %v, want [c -- d]", got)
+	}
+	if got := g.ArticulationPoints(); !slices.Equal(got, []string{"c", "d"}) {
+		t.Fatalf("ArticulationPoints = %v, want [c d]", got)
+	}
+
+	// The same link both ways in a directed graph is not a bridge, removing one direction keeps a and b connected
+	directed := graph.New[string, int](graph.Config{Directed: true})
+	directed.AddEdge("a", "b", 1)
+	directed.AddEdge("b", "a", 1)
+	if got := directed.Bridges(); len(got) != 0 {
+		t.Fatalf("Bridges of a -> b and b -> a = %v, want none", got)
+	}
+}
@@ -0,0 +1,17 @@
+package graph
+
+import "errors"
+
+// Sentinel errors returned by the graph operations
+var (
+	ErrVertexNotFound = errors.New("graph: vertex not found")
+	ErrEdgeNotFound   = errors.New("graph: edge not found")
+	ErrCycle          = errors.New("graph: graph has a cycle")
+	ErrUndirected     = errors.New("graph: operation needs a directed graph")
+	ErrDirected       = errors.New("graph: operation needs an undirected graph")
+	ErrInvalidInput   = errors.New("graph: invalid input")
+	ErrNegativeWeight = errors.New("graph: graph has a negative edge weight")
+	ErrNegativeCycle  = errors.New("graph: graph has a negative cycle")
+	ErrNoPath         = errors.New("graph: no path between the vertices")
+	ErrSourceIsSink   = errors.New("graph: source and sink are the same vertex")
+)
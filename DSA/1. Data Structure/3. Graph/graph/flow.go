@@ -0,0 +1,258 @@
+package graph
+
+import (
+	"slices"
+
+	"stackqueue/queue"
+)
+
+/*
+Flow is a maximum flow from a source to a sink
+Value is the amount that gets through. Edges lists every edge that carries flow, with the amount as its weight
+Paths splits the flow into source to sink paths, each with the amount it carries as its weight, they add up to Value
+Cut lists the vertices on the source side of a minimum cut, the edges leaving it are full and add up to Value as well
+*/
+type Flow[V comparable, W Number] struct {
+	Value W
+	Edges []Edge[V, W]
+	Paths []Path[V, W]
+	Cut   []V
+}
+
+// residualArc is an arc of the residual network, reverse is the index of the opposite arc in the list of to
+type residualArc[W Number] struct {
+	to       int
+	capacity W
+	flow     W
+	reverse  int
+}
+
+// residual is the residual network of a graph: every edge becomes an arc with its weight as capacity
+// and a reverse arc with capacity 0, pushing flow over an arc gives the same amount of capacity back on its reverse
+type residual[W Number] struct {
+	arcs [][]residualArc[W]
+}
+
+func (graph *Graph[V, W]) residual() *residual[W] {
+	network := &residual[W]{arcs: make([][]residualArc[W], len(graph.vertices))}
+	for from := range graph.vertices {
+		for to, capacity := range graph.backing.arcs(from) {
+			if from == to {
+				continue
+			}
+			network.arcs[from] = append(network.arcs[from], residualArc[W]{to: to, capacity: capacity, reverse: len(network.arcs[to])})
+			network.arcs[to] = append(network.arcs[to], residualArc[W]{to: from, reverse: len(network.arcs[from]) - 1})
+		}
+	}
+	return network
+}
+
+// push sends amount over the i-th arc of v
+func (network *residual[W]) push(v, i int, amount W) {
+	a := &network.arcs[v][i]
+	a.flow += amount
+	network.arcs[a.to][a.reverse].flow -= amount
+}
+
+// spare returns the capacity left on the i-th arc of v
+func (network *residual[W]) spare(v, i int) W {
+	a := network.arcs[v][i]
+	return a.capacity - a.flow
+}
+
+// levels returns the BFS distance in arcs with spare capacity from source to every vertex, -1 if unreachable,
+// and the arc each vertex was reached by
+func (network *residual[W]) levels(source int) (level, via []int) {
+	level, via = make([]int, len(network.arcs)), make([]int, len(network.arcs))
+	for v := range level {
+		level[v] = -1
+	}
+	level[source] = 0
+
+	var pending queue.Queue[int]
+	pending.Enqueue(source)
+	for !pending.IsEmpty() {
+		v, _ := pending.Dequeue()
+		for _, a := range network.arcs[v] {
+			if level[a.to] == -1 && a.flow < a.capacity {
+				level[a.to], via[a.to] = level[v]+1, a.reverse
+				pending.Enqueue(a.to)
+			}
+		}
+	}
+	return level, via
+}
+
+/*
+EdmondsKarp returns a maximum flow from source to sink, edge weights are the capacities
+It is Ford-Fulkerson with BFS: it keeps pushing flow along a shortest path with spare capacity
+until there is none. Shortest paths make the number of rounds O(V E), so it runs in O(V E^2)
+A negative capacity returns ErrNegativeWeight
+*/
+func (graph *Graph[V, W]) EdmondsKarp(source, sink V) (Flow[V, W], error) {
+	return graph.maxFlow(source, sink, func(network *residual[W], s, t int) {
+		for {
+			level, via := network.levels(s)
+			if level[t] == -1 {
+				return
+			}
+
+			// Walk back from the sink over the reverse arcs to collect the path, then push its bottleneck
+			type step struct{ from, arc int }
+			var path []step
+			for v := t; v != s; v = network.arcs[v][via[v]].to {
+				back := network.arcs[v][via[v]]
+				path = append(path, step{from: back.to, arc: back.reverse})
+			}
+
+			amount := network.spare(path[0].from, path[0].arc)
+			for _, p := range path[1:] {
+				amount = min(amount, network.spare(p.from, p.arc))
+			}
+			for _, p := range path {
+				network.push(p.from, p.arc, amount)
+			}
+		}
+	})
+}
+
+/*
+Dinic returns a maximum flow from source to sink, edge weights are the capacities
+Each phase builds the BFS levels from the source and then pushes a blocking flow using only arcs that go one level up,
+with a DFS that remembers per vertex which arcs are used up. There are at most V phases of O(V E) each, O(V^2 E),
+and it is much faster in practice, O(E sqrt V) on unit capacities
+A negative capacity returns ErrNegativeWeight
+*/
+func (graph *Graph[V, W]) Dinic(source, sink V) (Flow[V, W], error) {
+	return graph.maxFlow(source, sink, func(network *residual[W], s, t int) {
+		for {
+			level, _ := network.levels(s)
+			if level[t] == -1 {
+				return
+			}
+
+			next := make([]int, len(network.arcs))
+			var augment func(v int, limit W) W
+			augment = func(v int, limit W) W {
+				if v == t {
+					return limit
+				}
+				for ; next[v] < len(network.arcs[v]); next[v]++ {
+					a := network.arcs[v][next[v]]
+					if level[a.to] != level[v]+1 || a.flow >= a.capacity {
+						continue
+					}
+					if pushed := augment(a.to, min(limit, network.spare(v, next[v]))); pushed > 0 {
+						network.push(v, next[v], pushed)
+						return pushed
+					}
+				}
+				return 0
+			}
+
+			// Nothing can leave the source faster than its arcs allow, so their total is a safe limit
+			var total W
+			for _, a := range network.arcs[s] {
+				total += a.capacity
+			}
+			for augment(s, total) > 0 {
+			}
+		}
+	})
+}
+
+// maxFlow checks the arguments, runs the flow algorithm on the residual network and reads the result out of it
+func (graph *Graph[V, W]) maxFlow(source, sink V, algorithm func(network *residual[W], s, t int)) (Flow[V, W], error) {
+	s, okSource := graph.ids[source]
+	t, okSink := graph.ids[sink]
+	switch {
+	case !okSource || !okSink:
+		return Flow[V, W]{}, ErrVertexNotFound
+	case s == t:
+		return Flow[V, W]{}, ErrSourceIsSink
+	case graph.hasNegativeWeight():
+		return Flow[V, W]{}, ErrNegativeWeight
+	}
+
+	network := graph.residual()
+	algorithm(network, s, t)
+
+	var flow Flow[V, W]
+	for _, a := range network.arcs[s] {
+		flow.Value += a.flow
+	}
+
+	// Net flow per pair of vertices, so flow that went both ways between two vertices cancels out
+	net := make([]map[int]W, len(graph.vertices))
+	for v := range net {
+		net[v] = make(map[int]W)
+	}
+	for v, arcs := range network.arcs {
+		for _, a := range arcs {
+			if a.capacity > 0 {
+				net[v][a.to] += a.flow
+				net[a.to][v] -= a.flow
+			}
+		}
+	}
+	for v := range graph.vertices {
+		for w := range graph.backing.arcs(v) {
+			if amount := net[v][w]; amount > 0 {
+				flow.Edges = append(flow.Edges, Edge[V, W]{From: graph.vertices[v], To: graph.vertices[w], Weight: amount})
+			}
+		}
+	}
+
+	flow.Paths = graph.decompose(net, s, t)
+	level, _ := network.levels(s)
+	for v, l := range level {
+		if l != -1 {
+			flow.Cut = append(flow.Cut, graph.vertices[v])
+		}
+	}
+	return flow, nil
+}
+
+/*
+decompose splits the net flow into source to sink paths: it repeatedly finds a path of vertices with positive flow
+between them, takes the smallest amount on it and removes it from every step, so each round empties at least one pair
+What remains when no path is left are cycles of flow, which carry nothing from the source to the sink
+*/
+func (graph *Graph[V, W]) decompose(net []map[int]W, s, t int) []Path[V, W] {
+	var paths []Path[V, W]
+	for {
+		previous := make([]int, len(net))
+		for v := range previous {
+			previous[v] = -1
+		}
+		previous[s] = s
+
+		var pending queue.Queue[int]
+		pending.Enqueue(s)
+		for !pending.IsEmpty() && previous[t] == -1 {
+			v, _ := pending.Dequeue()
+			for w := range graph.backing.arcs(v) {
+				if previous[w] == -1 && net[v][w] > 0 {
+					previous[w] = v
+					pending.Enqueue(w)
+				}
+			}
+		}
+		if previous[t] == -1 {
+			return paths
+		}
+
+		ids := []int{t}
+		amount := net[previous[t]][t]
+		for v := t; v != s; v = previous[v] {
+			ids = append(ids, previous[v])
+			amount = min(amount, net[previous[v]][v])
+		}
+		slices.Reverse(ids)
+		for i := 1; i < len(ids); i++ {
+			net[ids[i-1]][ids[i]] -= amount
+			net[ids[i]][ids[i-1]] += amount
+		}
+		paths = append(paths, Path[V, W]{Vertices: graph.values(ids), Weight: amount})
+	}
+}
@@ -0,0 +1,281 @@
+/*
+Package graph provides a generic graph with the classic traversal, structure, shortest path, spanning tree and flow algorithms
+A Graph maps vertices of any comparable type to dense ids and stores weighted edges between them,
+either in adjacency lists or in an adjacency matrix, directed or undirected
+Vertices are listed in the order they were added and neighbors in the order their edges were added
+(or in id order for a matrix), so every traversal and algorithm gives the same answer on every run
+*/
+package graph
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// Number is the set of types an edge weight can have
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64
+}
+
+// Backing selects how a Graph stores its edges
+type Backing int
+
+const (
+	// AdjacencyList stores a slice of outgoing edges per vertex, for sparse graphs
+	AdjacencyList Backing = iota
+	// AdjacencyMatrix stores a V x V matrix, for small or dense graphs
+	AdjacencyMatrix
+)
+
+// Config holds the options of a Graph
+type Config struct {
+	Directed bool
+	Backing  Backing
+}
+
+// Edge is an edge from From to To with its weight, in an undirected graph From and To can be swapped
+type Edge[V comparable, W Number] struct {
+	From   V
+	To     V
+	Weight W
+}
+
+/*
+Graph represents a weighted graph with vertices of type V and weights of type W
+There is at most one edge from a vertex to another, adding it again replaces its weight
+Unweighted graphs simply use weight 1 everywhere
+*/
+type Graph[V comparable, W Number] struct {
+	directed bool
+	backing  backing[W]
+	ids      map[V]int
+	vertices []V
+	size     int
+}
+
+// New returns an empty graph with the given options
+func New[V comparable, W Number](config Config) *Graph[V, W] {
+	graph := &Graph[V, W]{directed: config.Directed, ids: make(map[V]int)}
+	if config.Backing == AdjacencyMatrix {
+		graph.backing = &adjacencyMatrix[W]{}
+	} else {
+		graph.backing = &adjacencyList[W]{}
+	}
+	return graph
+}
+
+// Directed reports whether the edges of the graph have a direction
+func (graph *Graph[V, W]) Directed() bool {
+	return graph.directed
+}
+
+// Order returns the number of vertices
+func (graph *Graph[V, W]) Order() int {
+	return len(graph.vertices)
+}
+
+// Size returns the number of edges, an undirected edge counts once
+func (graph *Graph[V, W]) Size() int {
+	return graph.size
+}
+
+// AddVertex adds v and reports whether it is new
+func (graph *Graph[V, W]) AddVertex(v V) bool {
+	if _, ok := graph.ids[v]; ok {
+		return false
+	}
+	graph.id(v)
+	return true
+}
+
+// HasVertex reports whether v is in the graph
+func (graph *Graph[V, W]) HasVertex(v V) bool {
+	_, ok := graph.ids[v]
+	return ok
+}
+
+/*
+AddEdge adds an edge from from to to with weight and adds both vertices if they are missing
+If the edge is already there only its weight is replaced. In an undirected graph the edge goes both ways
+*/
+func (graph *Graph[V, W]) AddEdge(from, to V, weight W) {
+	a, b := graph.id(from), graph.id(to)
+	isNew := graph.backing.setArc(a, b, weight)
+	if !graph.directed {
+		graph.backing.setArc(b, a, weight)
+	}
+	if isNew {
+		graph.size++
+	}
+}
+
+// RemoveEdge removes the edge from from to to, if there is no such edge it returns ErrEdgeNotFound
+func (graph *Graph[V, W]) RemoveEdge(from, to V) error {
+	a, okFrom := graph.ids[from]
+	b, okTo := graph.ids[to]
+	if !okFrom || !okTo || !graph.backing.removeArc(a, b) {
+		return ErrEdgeNotFound
+	}
+
+	if !graph.directed {
+		graph.backing.removeArc(b, a)
+	}
+	graph.size--
+	return nil
+}
+
+// Edge returns the weight of the edge from from to to and whether there is one
+func (graph *Graph[V, W]) Edge(from, to V) (W, bool) {
+	a, okFrom := graph.ids[from]
+	b, okTo := graph.ids[to]
+	if !okFrom || !okTo {
+		var zero W
+		return zero, false
+	}
+	return graph.backing.arc(a, b)
+}
+
+// Vertices returns an iterator over the vertices in the order they were added
+func (graph *Graph[V, W]) Vertices() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range graph.vertices {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbors returns an iterator over the vertices v has an edge to, with the weight of that edge
+func (graph *Graph[V, W]) Neighbors(v V) iter.Seq2[V, W] {
+	return func(yield func(V, W) bool) {
+		id, ok := graph.ids[v]
+		if !ok {
+			return
+		}
+		for to, weight := range graph.backing.arcs(id) {
+			if !yield(graph.vertices[to], weight) {
+				return
+			}
+		}
+	}
+}
+
+// Edges returns an iterator over every edge, an undirected edge is listed once, from the vertex that was added first
+func (graph *Graph[V, W]) Edges() iter.Seq[Edge[V, W]] {
+	return func(yield func(Edge[V, W]) bool) {
+		for from := range graph.vertices {
+			for to, weight := range graph.backing.arcs(from) {
+				if !graph.directed && to < from {
+					continue
+				}
+				if !yield(Edge[V, W]{From: graph.vertices[from], To: graph.vertices[to], Weight: weight}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Reverse returns a new graph with the same vertices, backing and edges, but every directed edge turned around
+func (graph *Graph[V, W]) Reverse() *Graph[V, W] {
+	reversed := graph.empty()
+	for edge := range graph.Edges() {
+		reversed.AddEdge(edge.To, edge.From, edge.Weight)
+	}
+	return reversed
+}
+
+// String lists the vertices with their edges, one vertex per line
+func (graph *Graph[V, W]) String() string {
+	arrow := " -- "
+	if graph.directed {
+		arrow = " -> "
+	}
+
+	var text strings.Builder
+	for from, v := range graph.vertices {
+		fmt.Fprintf(&text, "%v", v)
+		for to, weight := range graph.backing.arcs(from) {
+			fmt.Fprintf(&text, "%s%v (%v)", arrow, graph.vertices[to], weight)
+		}
+		text.WriteString("\n")
+	}
+	return text.String()
+}
+
+// id returns the id of v, adding v if it is missing
+func (graph *Graph[V, W]) id(v V) int {
+	if id, ok := graph.ids[v]; ok {
+		return id
+	}
+
+	id := len(graph.vertices)
+	graph.ids[v] = id
+	graph.vertices = append(graph.vertices, v)
+	graph.backing.addVertex()
+	return id
+}
+
+// empty returns a graph with the same options and vertices, in the same order, but no edges
+func (graph *Graph[V, W]) empty() *Graph[V, W] {
+	backing := AdjacencyList
+	if _, ok := graph.backing.(*adjacencyMatrix[W]); ok {
+		backing = AdjacencyMatrix
+	}
+
+	copied := New[V, W](Config{Directed: graph.directed, Backing: backing})
+	for _, v := range graph.vertices {
+		copied.id(v)
+	}
+	return copied
+}
+
+// link is an edge seen from one of its ends when directions are ignored, edge tells the edges apart
+type link struct {
+	to   int
+	edge int
+}
+
+/*
+links returns the links of every vertex id when edge directions are ignored
+Edge ids follow the order of Edges, so a directed edge and its opposite are two different edges
+*/
+func (graph *Graph[V, W]) links() [][]link {
+	links := make([][]link, len(graph.vertices))
+	edge := 0
+	for from := range graph.vertices {
+		for to := range graph.backing.arcs(from) {
+			if !graph.directed && to < from {
+				continue
+			}
+			links[from] = append(links[from], link{to: to, edge: edge})
+			if to != from {
+				links[to] = append(links[to], link{to: from, edge: edge})
+			}
+			edge++
+		}
+	}
+	return links
+}
+
+// predecessors returns, for every vertex id, the ids of the vertices with an edge to it
+func (graph *Graph[V, W]) predecessors() [][]int {
+	in := make([][]int, len(graph.vertices))
+	for from := range graph.vertices {
+		for to := range graph.backing.arcs(from) {
+			in[to] = append(in[to], from)
+		}
+	}
+	return in
+}
+
+// values returns the vertices with the given ids
+func (graph *Graph[V, W]) values(ids []int) []V {
+	vertices := make([]V, len(ids))
+	for i, id := range ids {
+		vertices[i] = graph.vertices[id]
+	}
+	return vertices
+}
@@ -0,0 +1,119 @@
+package graph_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"graph/graph"
+)
+
+// configs are the four kinds of graph every test runs on
+var configs = []struct {
+	name   string
+	config graph.Config
+}{
+	{"DirectedList", graph.Config{Directed: true, Backing: graph.AdjacencyList}},
+	{"UndirectedList", graph.Config{Directed: false, Backing: graph.AdjacencyList}},
+	{"DirectedMatrix", graph.Config{Directed: true, Backing: graph.AdjacencyMatrix}},
+	{"UndirectedMatrix", graph.Config{Directed: false, Backing: graph.AdjacencyMatrix}},
+}
+
+// randomGraph returns a graph with the vertices 0 to n-1 and up to m edges with weights in [low, high]
+func randomGraph(random *rand.Rand, config graph.Config, n, m, low, high int) *graph.Graph[int, int] {
+	g := graph.New[int, int](config)
+	for v := range n {
+		g.AddVertex(v)
+	}
+	for range m {
+		g.AddEdge(random.IntN(n), random.IntN(n), low+random.IntN(high-low+1))
+	}
+	return g
+}
+
+// randomGraphs calls check on 200 random graphs with up to 8 vertices of every config, each graph as its own subtest
+func randomGraphs(t *testing.T, check func(t *testing.T, g *graph.Graph[int, int])) {
+	for _, c := range configs {
+		t.Run(c.name, func(t *testing.T) {
+			random := rand.New(rand.NewPCG(1, 2))
+			for i := range 200 {
+				n := 1 + random.IntN(8)
+				g := randomGraph(random, c.config, n, random.IntN(2*n+2), 1, 1)
+				t.Run(fmt.Sprintf("Graph%d", i), func(t *testing.T) {
+					defer func() {
+						if t.Failed() {
+							t.Logf("graph:\n%v", g)
+						}
+					}()
+					check(t, g)
+				})
+			}
+		})
+	}
+}
+
+// reachable returns, for every vertex of g, which vertices it reaches, following the edge directions if follow is set
+func reachable(g *graph.Graph[int, int], follow bool) [][]bool {
+	n := g.Order()
+	neighbors := make([][]int, n)
+	for edge := range g.Edges() {
+		neighbors[edge.From] = append(neighbors[edge.From], edge.To)
+		if !g.Directed() || !follow {
+			neighbors[edge.To] = append(neighbors[edge.To], edge.From)
+		}
+	}
+
+	reaches := make([][]bool, n)
+	for s := range n {
+		reaches[s] = make([]bool, n)
+		pending := []int{s}
+		reaches[s][s] = true
+		for len(pending) > 0 {
+			v := pending[len(pending)-1]
+			pending = pending[:len(pending)-1]
+			for _, w := range neighbors[v] {
+				if !reaches[s][w] {
+					reaches[s][w] = true
+					pending = append(pending, w)
+				}
+			}
+		}
+	}
+	return reaches
+}
+
+func TestEdges(t *testing.T) {
+	for _, c := range configs {
+		g := graph.New[string, int](c.config)
+		g.AddEdge("a", "b", 1)
+		g.AddEdge("b", "c", 2)
+		g.AddEdge("a", "b", 3)
+		g.AddVertex("d")
+
+		if g.Order() != 4 || g.Size() != 2 || g.Directed() != c.config.Directed {
+			t.Fatalf("%s: Order %d, Size %d, Directed %t", c.name, g.Order(), g.Size(), g.Directed())
+		}
+		if weight, ok := g.Edge("a", "b"); !ok || weight != 3 {
+			t.Fatalf("%s: Edge(a, b) = %d, %t, want the replaced weight 3", c.name, weight, ok)
+		}
+		if _, ok := g.Edge("b", "a"); ok != !c.config.Directed {
+			t.Fatalf("%s: Edge(b, a) exists: %t", c.name, ok)
+		}
+
+		reversed := g.Reverse()
+		if _, ok := reversed.Edge("c", "b"); !ok || reversed.Size() != 2 || reversed.Order() != 4 {
+			t.Fatalf("%s: Reverse has no edge c -> b or %d edges", c.name, reversed.Size())
+		}
+
+		if err := g.RemoveEdge("c", "a"); !errors.Is(err, graph.ErrEdgeNotFound) {
+			t.Fatalf("%s: RemoveEdge of a missing edge returned %v", c.name, err)
+		}
+		if err := g.RemoveEdge("b", "c"); err != nil || g.Size() != 1 {
+			t.Fatalf("%s: RemoveEdge returned %v with %d edges left", c.name, err, g.Size())
+		}
+		if _, ok := g.Edge("c", "b"); ok {
+			t.Fatalf("%s: the removed edge is still there the other way", c.name)
+		}
+	}
+}
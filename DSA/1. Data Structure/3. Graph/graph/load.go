@@ -0,0 +1,400 @@
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+/*
+ReadEdgeList reads a graph with string vertices from r, one edge per line:
+
+	# a comment
+	a b        an edge from a to b with weight 1
+	a c 2.5    an edge from a to c with weight 2.5
+	d          a vertex without edges
+
+Fields are separated by white space and blank lines are skipped
+A malformed line or a weight that does not fit W returns an error wrapping ErrInvalidInput with the line number
+*/
+func ReadEdgeList[W Number](r io.Reader, config Config) (*Graph[string, W], error) {
+	graph := New[string, W](config)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text, _, _ := strings.Cut(scanner.Text(), "#")
+		fields := strings.Fields(text)
+		switch len(fields) {
+		case 0:
+		case 1:
+			graph.AddVertex(fields[0])
+		case 2, 3:
+			weight := W(1)
+			if len(fields) == 3 {
+				var err error
+				if weight, err = parseWeight[W](fields[2]); err != nil {
+					return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidInput, line, err)
+				}
+			}
+			graph.AddEdge(fields[0], fields[1], weight)
+		default:
+			return nil, fmt.Errorf("%w: line %d: want \"from to [weight]\", got %d fields", ErrInvalidInput, line, len(fields))
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
+
+/*
+ReadDOT reads a graph with string vertices from the Graphviz DOT text in r
+It understands the part of DOT that describes a graph's structure:
+
+	digraph name {           or graph, strict is accepted and ignored
+	    a;                   a vertex
+	    a -> b -> c;         a chain of edges, -- in an undirected graph
+	    a -> d [weight=2];   the weight attribute, or a numeric label, sets the weight, otherwise it is 1
+	    "quoted id" -> e;    ids are words, numbers or quoted strings
+	}
+
+In a quoted id \" stands for " and \\ for \, any other backslash is kept as it is
+
+Statements are separated by ; or , or new lines, comments are C and C++ style or lines starting with #
+Attribute statements (node [...], rankdir=LR) are skipped, subgraphs return an error
+The graph is directed for digraph and stored with the given backing
+A syntax error returns an error wrapping ErrInvalidInput with the line number
+*/
+func ReadDOT[W Number](r io.Reader, backing Backing) (*Graph[string, W], error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := tokenize(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &dotParser[W]{tokens: tokens}
+	if parser.keyword("strict") {
+		parser.next()
+	}
+	var directed bool
+	switch {
+	case parser.keyword("graph"):
+	case parser.keyword("digraph"):
+		directed = true
+	default:
+		return nil, parser.errorf("want graph or digraph")
+	}
+	parser.next()
+	if parser.peek().kind == identifier {
+		parser.next()
+	}
+	if err := parser.expect("{"); err != nil {
+		return nil, err
+	}
+
+	parser.graph = New[string, W](Config{Directed: directed, Backing: backing})
+	if err := parser.statements(); err != nil {
+		return nil, err
+	}
+	return parser.graph, nil
+}
+
+// parseWeight parses text as a weight of type W, failing if the value does not fit W exactly
+func parseWeight[W Number](text string) (W, error) {
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, fmt.Errorf("weight %q is not a number", text)
+	}
+
+	half := 0.5
+	weight := W(value)
+	if isFloat := W(half) != 0; !isFloat && float64(weight) != value {
+		return 0, fmt.Errorf("weight %q does not fit %T", text, weight)
+	}
+	return weight, nil
+}
+
+// tokenKind tells identifiers from punctuation, a quoted string is an identifier too
+type tokenKind int
+
+const (
+	identifier tokenKind = iota
+	punctuation
+	end
+)
+
+// token is one word of DOT text
+type token struct {
+	kind tokenKind
+	text string
+	line int
+}
+
+// tokenize splits DOT text into tokens, dropping white space and comments
+func tokenize(text string) ([]token, error) {
+	var tokens []token
+	line := 1
+	atLineStart := true
+	for i := 0; i < len(text); {
+		c := text[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+			atLineStart = true
+			continue
+		case unicode.IsSpace(rune(c)):
+			i++
+			continue
+		case c == '#' && atLineStart, strings.HasPrefix(text[i:], "//"):
+			for i < len(text) && text[i] != '\n' {
+				i++
+			}
+			continue
+		case strings.HasPrefix(text[i:], "/*"):
+			close := strings.Index(text[i+2:], "*/")
+			if close == -1 {
+				return nil, fmt.Errorf("%w: line %d: unterminated comment", ErrInvalidInput, line)
+			}
+			line += strings.Count(text[i:i+2+close], "\n")
+			i += close + 4
+			continue
+		}
+		atLineStart = false
+
+		switch {
+		case strings.HasPrefix(text[i:], "->"), strings.HasPrefix(text[i:], "--"):
+			tokens = append(tokens, token{kind: punctuation, text: text[i : i+2], line: line})
+			i += 2
+		case strings.ContainsRune("{}[];,=", rune(c)):
+			tokens = append(tokens, token{kind: punctuation, text: string(c), line: line})
+			i++
+		case c == '"':
+			var quoted strings.Builder
+			start := line
+			for i++; i < len(text) && text[i] != '"'; i++ {
+				if text[i] == '\\' && i+1 < len(text) && (text[i+1] == '"' || text[i+1] == '\\') {
+					i++
+				}
+				if text[i] == '\n' {
+					line++
+				}
+				quoted.WriteByte(text[i])
+			}
+			if i == len(text) {
+				return nil, fmt.Errorf("%w: line %d: unterminated string", ErrInvalidInput, start)
+			}
+			tokens = append(tokens, token{kind: identifier, text: quoted.String(), line: start})
+			i++
+		case isWordByte(c):
+			start := i
+			for i < len(text) && isWordByte(text[i]) && !strings.HasPrefix(text[i:], "--") && !strings.HasPrefix(text[i:], "->") {
+				i++
+			}
+			tokens = append(tokens, token{kind: identifier, text: text[start:i], line: line})
+		default:
+			return nil, fmt.Errorf("%w: line %d: unexpected character %q", ErrInvalidInput, line, c)
+		}
+	}
+	return append(tokens, token{kind: end, line: line}), nil
+}
+
+// isWordByte reports whether c can be part of an unquoted DOT id: letters, digits, _, ., - and any non ASCII byte
+func isWordByte(c byte) bool {
+	return c == '_' || c == '.' || c == '-' || c >= 0x80 ||
+		'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
+
+// dotParser reads the statements of a DOT graph into graph
+type dotParser[W Number] struct {
+	tokens   []token
+	position int
+	graph    *Graph[string, W]
+}
+
+func (parser *dotParser[W]) peek() token {
+	return parser.tokens[parser.position]
+}
+
+func (parser *dotParser[W]) next() token {
+	t := parser.tokens[parser.position]
+	if t.kind != end {
+		parser.position++
+	}
+	return t
+}
+
+// keyword reports whether the next token is the DOT keyword word, keywords are case insensitive
+func (parser *dotParser[W]) keyword(word string) bool {
+	t := parser.peek()
+	return t.kind == identifier && strings.EqualFold(t.text, word)
+}
+
+// is reports whether the next token is the punctuation text
+func (parser *dotParser[W]) is(text string) bool {
+	t := parser.peek()
+	return t.kind == punctuation && t.text == text
+}
+
+func (parser *dotParser[W]) expect(text string) error {
+	if !parser.is(text) {
+		return parser.errorf("want %q", text)
+	}
+	parser.next()
+	return nil
+}
+
+func (parser *dotParser[W]) errorf(format string, args ...any) error {
+	t := parser.peek()
+	found := t.text
+	if t.kind == end {
+		found = "end of input"
+	}
+	return fmt.Errorf("%w: line %d: %s, got %q", ErrInvalidInput, t.line, fmt.Sprintf(format, args...), found)
+}
+
+// statements reads statements up to and including the closing }
+func (parser *dotParser[W]) statements() error {
+	for {
+		switch {
+		case parser.is("}"):
+			parser.next()
+			if parser.peek().kind != end {
+				return parser.errorf("want end of input")
+			}
+			return nil
+		case parser.is(";"), parser.is(","):
+			parser.next()
+		case parser.keyword("subgraph"), parser.is("{"):
+			return parser.errorf("subgraphs are not supported")
+		case parser.keyword("graph"), parser.keyword("node"), parser.keyword("edge"):
+			parser.next()
+			if _, err := parser.attributes(); err != nil {
+				return err
+			}
+		case parser.peek().kind == identifier:
+			if err := parser.statement(); err != nil {
+				return err
+			}
+		default:
+			return parser.errorf("want a statement")
+		}
+	}
+}
+
+// statement reads a vertex, an edge chain or a graph attribute assignment
+func (parser *dotParser[W]) statement() error {
+	ids := []string{parser.next().text}
+	if parser.is("=") {
+		parser.next()
+		if parser.peek().kind != identifier {
+			return parser.errorf("want a value")
+		}
+		parser.next()
+		return nil
+	}
+
+	operator := "--"
+	if parser.graph.Directed() {
+		operator = "->"
+	}
+	for parser.is("->") || parser.is("--") {
+		if !parser.is(operator) {
+			return parser.errorf("want %q in this graph", operator)
+		}
+		parser.next()
+		if parser.peek().kind != identifier {
+			return parser.errorf("want a vertex")
+		}
+		ids = append(ids, parser.next().text)
+	}
+
+	attributes, err := parser.attributes()
+	if err != nil {
+		return err
+	}
+
+	if len(ids) == 1 {
+		parser.graph.AddVertex(ids[0])
+		return nil
+	}
+
+	weight := W(1)
+	text, ok := attributes["weight"]
+	if !ok {
+		text, ok = attributes["label"]
+		if _, err := strconv.ParseFloat(text, 64); err != nil {
+			ok = false
+		}
+	}
+	if ok {
+		if weight, err = parseWeight[W](text); err != nil {
+			return fmt.Errorf("%w: line %d: %v", ErrInvalidInput, parser.peek().line, err)
+		}
+	}
+
+	for i := 1; i < len(ids); i++ {
+		parser.graph.AddEdge(ids[i-1], ids[i], weight)
+	}
+	return nil
+}
+
+// attributes reads any number of [name=value, ...] lists and returns the attributes
+func (parser *dotParser[W]) attributes() (map[string]string, error) {
+	attributes := make(map[string]string)
+	for parser.is("[") {
+		parser.next()
+		for !parser.is("]") {
+			if parser.peek().kind != identifier {
+				return nil, parser.errorf("want an attribute name")
+			}
+			name := parser.next().text
+			if err := parser.expect("="); err != nil {
+				return nil, err
+			}
+			if parser.peek().kind != identifier {
+				return nil, parser.errorf("want an attribute value")
+			}
+			attributes[name] = parser.next().text
+			if parser.is(",") || parser.is(";") {
+				parser.next()
+			}
+		}
+		parser.next()
+	}
+	return attributes, nil
+}
+
+// ToDOT returns the graph in Graphviz DOT format, which ReadDOT reads back, weights are written as edge labels
+func (graph *Graph[V, W]) ToDOT() string {
+	kind, arrow := "graph", "--"
+	if graph.directed {
+		kind, arrow = "digraph", "->"
+	}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "%s {\n", kind)
+	for _, v := range graph.vertices {
+		fmt.Fprintf(&text, "\t%s;\n", quote(v))
+	}
+	for edge := range graph.Edges() {
+		fmt.Fprintf(&text, "\t%s %s %s [label=\"%v\"];\n", quote(edge.From), arrow, quote(edge.To), edge.Weight)
+	}
+	text.WriteString("}\n")
+	return text.String()
+}
+
+// escaper escapes the characters ReadDOT unescapes in a quoted id
+var escaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// quote returns v as a quoted DOT id
+func quote(v any) string {
+	return `"` + escaper.Replace(fmt.Sprint(v)) + `"`
+}
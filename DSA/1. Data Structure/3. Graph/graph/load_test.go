@@ -0,0 +1,181 @@
+package graph_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"graph/graph"
+)
+
+// sameGraph fails t unless got has the vertices of want in the same order and the same edges with the same weights
+func sameGraph[W graph.Number](t *testing.T, name string, got, want *graph.Graph[string, W]) {
+	t.Helper()
+
+	if g, w := slices.Collect(got.Vertices()), slices.Collect(want.Vertices()); !slices.Equal(g, w) {
+		t.Fatalf("%s: got vertices %q, want %q", name, g, w)
+	}
+	if got.Directed() != want.Directed() || got.Size() != want.Size() {
+		t.Fatalf("%s: got %d edges, directed %t, want %d, directed %t", name, got.Size(), got.Directed(), want.Size(), want.Directed())
+	}
+	for edge := range want.Edges() {
+		if weight, ok := got.Edge(edge.From, edge.To); !ok || weight != edge.Weight {
+			t.Fatalf("%s: edge %q -> %q has weight %v, %t, want %v", name, edge.From, edge.To, weight, ok, edge.Weight)
+		}
+	}
+}
+
+// build returns a graph of config with the given vertices added first, then the edges
+func build[W graph.Number](config graph.Config, vertices []string, edges ...graph.Edge[string, W]) *graph.Graph[string, W] {
+	g := graph.New[string, W](config)
+	for _, v := range vertices {
+		g.AddVertex(v)
+	}
+	for _, edge := range edges {
+		g.AddEdge(edge.From, edge.To, edge.Weight)
+	}
+	return g
+}
+
+func TestReadEdgeList(t *testing.T) {
+	text := `
+# a comment line
+a b
+a c 2.5   # a trailing comment
+
+d
+c b -1
+`
+	edges := []graph.Edge[string, float64]{{From: "a", To: "b", Weight: 1}, {From: "a", To: "c", Weight: 2.5}, {From: "c", To: "b", Weight: -1}}
+	for _, c := range configs {
+		got, err := graph.ReadEdgeList[float64](strings.NewReader(text), c.config)
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		sameGraph(t, c.name, got, build(c.config, []string{"a", "b", "c", "d"}, edges...))
+	}
+}
+
+func TestReadEdgeListInvalid(t *testing.T) {
+	inputs := []struct {
+		name, text, line string
+	}{
+		{"TooManyFields", "a b\na b 1 2\n", "line 2"},
+		{"NotANumber", "a b one\n", "line 1"},
+		{"FractionForInt", "a b 1\n\na c 2.5\n", "line 3"},
+		{"Overflow", "a b 1e30\n", "line 1"},
+	}
+
+	for _, input := range inputs {
+		_, err := graph.ReadEdgeList[int](strings.NewReader(input.text), graph.Config{})
+		if !errors.Is(err, graph.ErrInvalidInput) || !strings.Contains(err.Error(), input.line+":") {
+			t.Fatalf("%s: got %v, want %v at %s", input.name, err, graph.ErrInvalidInput, input.line)
+		}
+	}
+}
+
+func TestReadDOT(t *testing.T) {
+	text := `strict digraph "name" {
+	# a preprocessor line is a comment
+	rankdir=LR
+	node [shape=box]; edge [color="red"]
+	a; // a vertex on its own
+	a -> b -> c [weight=3]
+	/* a comment
+	   over two lines */
+	"quoted \"id\"" -> a [label=2, color=blue]
+	c -> d [label="not a number"], e
+	f -> g [label="4"; weight=5]
+}`
+	want := build(graph.Config{Directed: true}, []string{"a", "b", "c", `quoted "id"`, "d", "e", "f", "g"},
+		graph.Edge[string, int]{From: "a", To: "b", Weight: 3},
+		graph.Edge[string, int]{From: "b", To: "c", Weight: 3},
+		graph.Edge[string, int]{From: `quoted "id"`, To: "a", Weight: 2},
+		graph.Edge[string, int]{From: "c", To: "d", Weight: 1},
+		graph.Edge[string, int]{From: "f", To: "g", Weight: 5},
+	)
+
+	for _, backing := range []graph.Backing{graph.AdjacencyList, graph.AdjacencyMatrix} {
+		got, err := graph.ReadDOT[int](strings.NewReader(text), backing)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sameGraph(t, "digraph", got, want)
+	}
+
+	undirected, err := graph.ReadDOT[int](strings.NewReader("GRAPH { x -- y -- z; z -- x }"), graph.AdjacencyList)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := undirected.Edge("x", "z"); !ok || undirected.Directed() || undirected.Size() != 3 {
+		t.Fatalf("undirected graph: got %d edges, directed %t", undirected.Size(), undirected.Directed())
+	}
+}
+
+func TestReadDOTInvalid(t *testing.T) {
+	inputs := []struct {
+		name, text, line string
+	}{
+		{"NotAGraph", "tree { a }", "line 1"},
+		{"MissingBrace", "graph\n a -- b }", "line 2"},
+		{"Unclosed", "graph {\n a -- b\n", "line 3"},
+		{"TrailingText", "graph { a } b", "line 1"},
+		{"Subgraph", "graph {\n subgraph s { a } }", "line 2"},
+		{"NestedBraces", "graph { { a } }", "line 1"},
+		{"WrongOperator", "graph {\n a -> b }", "line 2"},
+		{"MissingVertex", "digraph { a -> ; }", "line 1"},
+		{"MissingValue", "graph { rankdir = ; }", "line 1"},
+		{"MissingAttributeValue", "graph { a [color=] }", "line 1"},
+		{"MissingEquals", "graph { a [color] }", "line 1"},
+		{"UnclosedAttributes", "graph { a [color=red", "line 1"},
+		{"BadWeight", "graph {\n a -- b [weight=heavy] }", "line 2"},
+		{"FractionForInt", "graph { a -- b [weight=1.5] }", "line 1"},
+		{"UnterminatedString", "graph {\n \"a -- b }", "line 2"},
+		{"UnterminatedComment", "graph { a /* b }", "line 1"},
+		{"UnexpectedCharacter", "graph {\n\n a -- b; @ }", "line 3"},
+		{"Empty", "", "line 1"},
+	}
+
+	for _, input := range inputs {
+		_, err := graph.ReadDOT[int](strings.NewReader(input.text), graph.AdjacencyList)
+		if !errors.Is(err, graph.ErrInvalidInput) || !strings.Contains(err.Error(), input.line+":") {
+			t.Fatalf("%s: got %v, want %v at %s", input.name, err, graph.ErrInvalidInput, input.line)
+		}
+	}
+}
+
+// names are vertex names that need quoting in DOT, the backslashes and quotes need escaping too
+var names = []string{`a\`, `"`, `\"`, `a\\b`, `say "hi"`, "two words", "new\nline", "->", "x", "", "ünïcode", `\n`}
+
+// TestDOTRoundTrip writes graphs with awkward vertex names with ToDOT and reads them back with ReadDOT
+func TestDOTRoundTrip(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+	for _, c := range configs {
+		for range 50 {
+			want := graph.New[string, float64](c.config)
+			for _, i := range random.Perm(len(names)) {
+				want.AddVertex(names[i])
+			}
+			for range random.IntN(20) {
+				from, to := names[random.IntN(len(names))], names[random.IntN(len(names))]
+				want.AddEdge(from, to, float64(random.IntN(20)-10)/4)
+			}
+
+			text := want.ToDOT()
+			got, err := graph.ReadDOT[float64](strings.NewReader(text), c.config.Backing)
+			if err != nil {
+				t.Fatalf("%s: %v in\n%s", c.name, err, text)
+			}
+			sameGraph(t, fmt.Sprintf("%s from\n%s", c.name, text), got, want)
+		}
+	}
+
+	backslash := graph.New[string, int](graph.Config{Directed: true})
+	backslash.AddEdge(`a\`, "b", 1)
+	if text := backslash.ToDOT(); !strings.Contains(text, `"a\\" -> "b"`) {
+		t.Fatalf("a\\ is not escaped in\n%s", text)
+	}
+}
@@ -0,0 +1,284 @@
+package graph
+
+import (
+	"math"
+	"slices"
+)
+
+// Path is a walk through the graph, Vertices lists it from start to end and Weight is the sum of its edge weights
+type Path[V comparable, W Number] struct {
+	Vertices []V
+	Weight   W
+}
+
+// NegativeCycleError is returned by the shortest path algorithms on a graph with a negative cycle, Cycle lists its vertices
+type NegativeCycleError[V comparable] struct {
+	Cycle []V
+}
+
+func (err *NegativeCycleError[V]) Error() string {
+	return formatCycle(ErrNegativeCycle, err.Cycle)
+}
+
+// Unwrap makes errors.Is(err, ErrNegativeCycle) true
+func (err *NegativeCycleError[V]) Unwrap() error {
+	return ErrNegativeCycle
+}
+
+// tree is a shortest path tree over vertex ids: previous[v] is the vertex before v on its path, -1 for the source
+type tree[W Number] struct {
+	distance []W
+	previous []int
+	reached  []bool
+}
+
+func newTree[W Number](n int) tree[W] {
+	t := tree[W]{distance: make([]W, n), previous: make([]int, n), reached: make([]bool, n)}
+	for v := range t.previous {
+		t.previous[v] = -1
+	}
+	return t
+}
+
+// path returns the ids on the path from the root of the tree to v
+func (t tree[W]) path(v int) []int {
+	var ids []int
+	for ; v != -1; v = t.previous[v] {
+		ids = append(ids, v)
+	}
+	slices.Reverse(ids)
+	return ids
+}
+
+/*
+ShortestPaths holds the shortest paths from one source to every vertex, as computed by Dijkstra or BellmanFord
+It stores one distance and one previous vertex per vertex, so every path is rebuilt in O(length) when asked for
+*/
+type ShortestPaths[V comparable, W Number] struct {
+	graph *Graph[V, W]
+	tree  tree[W]
+}
+
+// Distance returns the weight of the shortest path to v and whether v is reachable from the source
+func (paths *ShortestPaths[V, W]) Distance(v V) (W, bool) {
+	id, ok := paths.graph.ids[v]
+	if !ok || !paths.tree.reached[id] {
+		var zero W
+		return zero, false
+	}
+	return paths.tree.distance[id], true
+}
+
+// To returns the shortest path from the source to v and whether v is reachable from the source
+func (paths *ShortestPaths[V, W]) To(v V) (Path[V, W], bool) {
+	id, ok := paths.graph.ids[v]
+	if !ok || !paths.tree.reached[id] {
+		return Path[V, W]{}, false
+	}
+	return Path[V, W]{Vertices: paths.graph.values(paths.tree.path(id)), Weight: paths.tree.distance[id]}, true
+}
+
+// Dijkstra returns the shortest paths from source using a binary heap, see dijkstra
+func (graph *Graph[V, W]) Dijkstra(source V) (*ShortestPaths[V, W], error) {
+	return graph.shortestPaths(source, &binaryHeap[W]{})
+}
+
+// DijkstraPairing returns the shortest paths from source using a pairing heap, see dijkstra
+func (graph *Graph[V, W]) DijkstraPairing(source V) (*ShortestPaths[V, W], error) {
+	return graph.shortestPaths(source, newPairingHeap[W](len(graph.vertices)))
+}
+
+func (graph *Graph[V, W]) shortestPaths(source V, queue frontier[W]) (*ShortestPaths[V, W], error) {
+	id, ok := graph.ids[source]
+	if !ok {
+		return nil, ErrVertexNotFound
+	}
+	if graph.hasNegativeWeight() {
+		return nil, ErrNegativeWeight
+	}
+	return &ShortestPaths[V, W]{graph: graph, tree: graph.dijkstra(id, queue, nil)}, nil
+}
+
+/*
+dijkstra grows the shortest path tree from source, always settling the closest unsettled vertex next
+With every weight >= 0 a settled vertex can never get closer, so each vertex is settled once:
+O((V + E) log V) with a binary heap, O(E + V log V) amortized with a pairing heap that decreases keys in place
+reweight, if not nil, changes the weight of the edge from -> to, Johnson uses it to make every weight >= 0
+*/
+func (graph *Graph[V, W]) dijkstra(source int, queue frontier[W], reweight func(from, to int, weight W) W) tree[W] {
+	t := newTree[W](len(graph.vertices))
+	settled := make([]bool, len(graph.vertices))
+	t.reached[source] = true
+	queue.push(source, 0)
+
+	for !queue.empty() {
+		v, d := queue.pop()
+		if settled[v] || d != t.distance[v] {
+			continue
+		}
+		settled[v] = true
+
+		for w, weight := range graph.backing.arcs(v) {
+			if reweight != nil {
+				weight = reweight(v, w, weight)
+			}
+			if candidate := d + weight; !settled[w] && (!t.reached[w] || candidate < t.distance[w]) {
+				t.distance[w], t.previous[w], t.reached[w] = candidate, v, true
+				queue.push(w, candidate)
+			}
+		}
+	}
+	return t
+}
+
+/*
+BellmanFord returns the shortest paths from source and also handles negative weights
+It relaxes every edge V - 1 times, after which every shortest path (at most V - 1 edges long) is final, O(V E)
+If a further round still improves a distance there is a negative cycle reachable from source,
+which is returned in a *NegativeCycleError. In an undirected graph a negative edge is such a cycle by itself
+*/
+func (graph *Graph[V, W]) BellmanFord(source V) (*ShortestPaths[V, W], error) {
+	id, ok := graph.ids[source]
+	if !ok {
+		return nil, ErrVertexNotFound
+	}
+
+	t, cycle := graph.bellmanFord([]int{id})
+	if cycle != nil {
+		return nil, &NegativeCycleError[V]{Cycle: graph.values(cycle)}
+	}
+	return &ShortestPaths[V, W]{graph: graph, tree: t}, nil
+}
+
+/*
+bellmanFord runs Bellman-Ford from all sources at once at distance 0 and returns the tree or a negative cycle
+It stops early after a round without improvements
+When the last round still improves a vertex, following previous V times from it must end on the cycle
+*/
+func (graph *Graph[V, W]) bellmanFord(sources []int) (tree[W], []int) {
+	n := len(graph.vertices)
+	t := newTree[W](n)
+	for _, s := range sources {
+		t.reached[s] = true
+	}
+
+	for round := 0; round < n; round++ {
+		improved := -1
+		for v := range graph.vertices {
+			if !t.reached[v] {
+				continue
+			}
+			for w, weight := range graph.backing.arcs(v) {
+				if candidate := t.distance[v] + weight; !t.reached[w] || candidate < t.distance[w] {
+					t.distance[w], t.previous[w], t.reached[w] = candidate, v, true
+					improved = w
+				}
+			}
+		}
+
+		switch {
+		case improved == -1:
+			return t, nil
+		case round == n-1:
+			v := improved
+			for range n {
+				v = t.previous[v]
+			}
+			cycle := []int{v}
+			for u := t.previous[v]; u != v; u = t.previous[u] {
+				cycle = append(cycle, u)
+			}
+			slices.Reverse(cycle)
+			return t, cycle
+		}
+	}
+	return t, nil
+}
+
+/*
+AStar returns the shortest path from source to target, or ErrNoPath
+It is Dijkstra ordered by distance so far plus heuristic(v), an estimate of the distance from v to target,
+so it explores towards the target first and can stop as soon as the target is settled
+The heuristic must never overestimate (be admissible) for the path to be the shortest. If it is also consistent,
+h(u) <= weight(u, v) + h(v), every vertex is settled once, otherwise vertices may be settled again
+ZeroHeuristic turns it into Dijkstra, ManhattanHeuristic and EuclideanHeuristic suit vertices placed on a plane
+*/
+func (graph *Graph[V, W]) AStar(source, target V, heuristic func(v V) W) (Path[V, W], error) {
+	from, okFrom := graph.ids[source]
+	to, okTo := graph.ids[target]
+	if !okFrom || !okTo {
+		return Path[V, W]{}, ErrVertexNotFound
+	}
+	if graph.hasNegativeWeight() {
+		return Path[V, W]{}, ErrNegativeWeight
+	}
+
+	t := newTree[W](len(graph.vertices))
+	estimate := make([]W, len(graph.vertices))
+	t.reached[from] = true
+	estimate[from] = heuristic(source)
+
+	queue := &binaryHeap[W]{}
+	queue.push(from, estimate[from])
+	for !queue.empty() {
+		v, f := queue.pop()
+		if f != t.distance[v]+estimate[v] {
+			continue
+		}
+		if v == to {
+			return Path[V, W]{Vertices: graph.values(t.path(to)), Weight: t.distance[to]}, nil
+		}
+
+		for w, weight := range graph.backing.arcs(v) {
+			if candidate := t.distance[v] + weight; !t.reached[w] || candidate < t.distance[w] {
+				if !t.reached[w] {
+					estimate[w] = heuristic(graph.vertices[w])
+				}
+				t.distance[w], t.previous[w], t.reached[w] = candidate, v, true
+				queue.push(w, candidate+estimate[w])
+			}
+		}
+	}
+	return Path[V, W]{}, ErrNoPath
+}
+
+// ZeroHeuristic is the heuristic that knows nothing, A* with it explores like Dijkstra
+func ZeroHeuristic[V comparable, W Number](V) W {
+	return 0
+}
+
+/*
+ManhattanHeuristic returns a heuristic for vertices at the points position returns, when moving only along the axes:
+the sum of the distances to target along x and along y. It is admissible when every edge weighs at least that distance
+*/
+func ManhattanHeuristic[V comparable, W Number](target V, position func(v V) (x, y float64)) func(v V) W {
+	tx, ty := position(target)
+	return func(v V) W {
+		x, y := position(v)
+		return W(math.Abs(x-tx) + math.Abs(y-ty))
+	}
+}
+
+/*
+EuclideanHeuristic returns a heuristic for vertices at the points position returns: the straight line distance to target
+It is admissible when every edge weighs at least the distance between its ends, for integer weights it rounds down
+*/
+func EuclideanHeuristic[V comparable, W Number](target V, position func(v V) (x, y float64)) func(v V) W {
+	tx, ty := position(target)
+	return func(v V) W {
+		x, y := position(v)
+		return W(math.Hypot(x-tx, y-ty))
+	}
+}
+
+// hasNegativeWeight reports whether any edge weighs less than 0
+func (graph *Graph[V, W]) hasNegativeWeight() bool {
+	for v := range graph.vertices {
+		for _, weight := range graph.backing.arcs(v) {
+			if weight < 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
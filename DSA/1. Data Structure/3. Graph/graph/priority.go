@@ -0,0 +1,169 @@
+package graph
+
+/*
+frontier is the priority queue of vertex ids used by Dijkstra, A* and Prim
+push offers v with priority p. A queue may keep an older, larger priority for v around,
+so callers skip popped entries whose priority no longer matches their own bookkeeping
+*/
+type frontier[W Number] interface {
+	push(v int, p W)
+	pop() (int, W)
+	empty() bool
+}
+
+// entry is a vertex id with its priority
+type entry[W Number] struct {
+	v int
+	p W
+}
+
+/*
+binaryHeap is a min heap in a slice. It has no decrease key: pushing a vertex again adds a second entry
+and the stale one is skipped when it is popped, so it holds up to E entries. push and pop are O(log E)
+*/
+type binaryHeap[W Number] struct {
+	entries []entry[W]
+}
+
+func (heap *binaryHeap[W]) empty() bool {
+	return len(heap.entries) == 0
+}
+
+func (heap *binaryHeap[W]) push(v int, p W) {
+	heap.entries = append(heap.entries, entry[W]{v: v, p: p})
+	for i := len(heap.entries) - 1; i > 0; {
+		parent := (i - 1) / 2
+		if heap.entries[parent].p <= heap.entries[i].p {
+			break
+		}
+		heap.entries[parent], heap.entries[i] = heap.entries[i], heap.entries[parent]
+		i = parent
+	}
+}
+
+func (heap *binaryHeap[W]) pop() (int, W) {
+	top := heap.entries[0]
+	last := len(heap.entries) - 1
+	heap.entries[0] = heap.entries[last]
+	heap.entries = heap.entries[:last]
+
+	for i := 0; ; {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < last && heap.entries[child].p < heap.entries[smallest].p {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			break
+		}
+		heap.entries[i], heap.entries[smallest] = heap.entries[smallest], heap.entries[i]
+		i = smallest
+	}
+	return top.v, top.p
+}
+
+// pairingNode is a tree node of a pairing heap: child is its first child, next its next sibling and prev
+// its previous sibling, or its parent if it is the first child
+type pairingNode[W Number] struct {
+	v                 int
+	p                 W
+	child, next, prev *pairingNode[W]
+}
+
+/*
+pairingHeap is a heap ordered tree where every node keeps its children in a linked list
+push is O(1), a decrease key (pushing a vertex that is already queued with a smaller priority) cuts the vertex's
+subtree off and melds it back at the root in O(1), and pop pairs up the root's children in O(log n) amortized
+Unlike binaryHeap it holds every vertex at most once
+*/
+type pairingHeap[W Number] struct {
+	root  *pairingNode[W]
+	nodes []*pairingNode[W]
+}
+
+// newPairingHeap returns an empty pairing heap for the vertex ids 0 to n-1
+func newPairingHeap[W Number](n int) *pairingHeap[W] {
+	return &pairingHeap[W]{nodes: make([]*pairingNode[W], n)}
+}
+
+func (heap *pairingHeap[W]) empty() bool {
+	return heap.root == nil
+}
+
+func (heap *pairingHeap[W]) push(v int, p W) {
+	node := heap.nodes[v]
+	if node == nil {
+		node = &pairingNode[W]{v: v, p: p}
+		heap.nodes[v] = node
+		heap.root = meld(heap.root, node)
+		return
+	}
+
+	if p >= node.p {
+		return
+	}
+	node.p = p
+	if node == heap.root {
+		return
+	}
+
+	// Cut the subtree of node out of its sibling list and meld it with the root
+	if node.prev.child == node {
+		node.prev.child = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next != nil {
+		node.next.prev = node.prev
+	}
+	node.next, node.prev = nil, nil
+	heap.root = meld(heap.root, node)
+}
+
+func (heap *pairingHeap[W]) pop() (int, W) {
+	top := heap.root
+	heap.nodes[top.v] = nil
+
+	// Meld the children in pairs from left to right, then meld the pairs from right to left
+	var pairs []*pairingNode[W]
+	for child := top.child; child != nil; {
+		first := child
+		second := first.next
+		child = nil
+		if second != nil {
+			child = second.next
+			second.next, second.prev = nil, nil
+		}
+		first.next, first.prev = nil, nil
+		pairs = append(pairs, meld(first, second))
+	}
+
+	var root *pairingNode[W]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = meld(pairs[i], root)
+	}
+	heap.root = root
+	return top.v, top.p
+}
+
+// meld makes the root with the larger priority the first child of the other and returns the new root
+func meld[W Number](a, b *pairingNode[W]) *pairingNode[W] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if b.p < a.p {
+		a, b = b, a
+	}
+
+	b.prev = a
+	b.next = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	return a
+}
@@ -0,0 +1,133 @@
+package graph
+
+import (
+	"cmp"
+	"slices"
+)
+
+// SpanningForest is a minimum spanning tree of every connected component, Weight is the sum of its edge weights
+type SpanningForest[V comparable, W Number] struct {
+	Edges  []Edge[V, W]
+	Weight W
+}
+
+/*
+unionFind keeps a partition of the ids 0 to n-1 into disjoint sets
+Every set is a tree of parent links whose root names the set. Union hangs the smaller tree under the larger one
+and find halves the path it walks, which together make both nearly O(1) amortized
+*/
+type unionFind struct {
+	parent []int
+	size   []int
+}
+
+func newUnionFind(n int) *unionFind {
+	sets := &unionFind{parent: make([]int, n), size: make([]int, n)}
+	for i := range sets.parent {
+		sets.parent[i], sets.size[i] = i, 1
+	}
+	return sets
+}
+
+// find returns the root of the set holding x
+func (sets *unionFind) find(x int) int {
+	for sets.parent[x] != x {
+		sets.parent[x] = sets.parent[sets.parent[x]]
+		x = sets.parent[x]
+	}
+	return x
+}
+
+// union merges the sets holding a and b and reports whether they were different sets
+func (sets *unionFind) union(a, b int) bool {
+	a, b = sets.find(a), sets.find(b)
+	if a == b {
+		return false
+	}
+	if sets.size[a] < sets.size[b] {
+		a, b = b, a
+	}
+	sets.parent[b] = a
+	sets.size[a] += sets.size[b]
+	return true
+}
+
+/*
+Kruskal returns a minimum spanning forest of an undirected graph, or ErrDirected
+It takes the edges from light to heavy and keeps every edge that joins two different trees,
+a union-find tells in nearly O(1) whether the ends are already connected, so sorting dominates: O(E log E)
+*/
+func (graph *Graph[V, W]) Kruskal() (SpanningForest[V, W], error) {
+	if graph.directed {
+		return SpanningForest[V, W]{}, ErrDirected
+	}
+
+	type indexed struct {
+		from, to int
+		weight   W
+	}
+	var edges []indexed
+	for from := range graph.vertices {
+		for to, weight := range graph.backing.arcs(from) {
+			if from < to {
+				edges = append(edges, indexed{from: from, to: to, weight: weight})
+			}
+		}
+	}
+	slices.SortStableFunc(edges, func(a, b indexed) int { return cmp.Compare(a.weight, b.weight) })
+
+	var forest SpanningForest[V, W]
+	sets := newUnionFind(len(graph.vertices))
+	for _, edge := range edges {
+		if sets.union(edge.from, edge.to) {
+			forest.Edges = append(forest.Edges, Edge[V, W]{From: graph.vertices[edge.from], To: graph.vertices[edge.to], Weight: edge.weight})
+			forest.Weight += edge.weight
+		}
+	}
+	return forest, nil
+}
+
+/*
+Prim returns a minimum spanning forest of an undirected graph, or ErrDirected
+It grows one tree at a time from its first vertex, always adding the lightest edge from the tree to a vertex outside it,
+taken from a binary heap keyed by that edge weight: O(E log V). Each edge is listed from the tree side
+*/
+func (graph *Graph[V, W]) Prim() (SpanningForest[V, W], error) {
+	if graph.directed {
+		return SpanningForest[V, W]{}, ErrDirected
+	}
+
+	n := len(graph.vertices)
+	inTree := make([]bool, n)
+	t := newTree[W](n)
+
+	var forest SpanningForest[V, W]
+	for root := range graph.vertices {
+		if inTree[root] {
+			continue
+		}
+
+		queue := &binaryHeap[W]{}
+		t.reached[root] = true
+		queue.push(root, 0)
+		for !queue.empty() {
+			v, weight := queue.pop()
+			if inTree[v] || weight != t.distance[v] {
+				continue
+			}
+			inTree[v] = true
+			if parent := t.previous[v]; parent != -1 {
+				forest.Edges = append(forest.Edges, Edge[V, W]{From: graph.vertices[parent], To: graph.vertices[v], Weight: weight})
+				forest.Weight += weight
+			}
+
+			for w, weight := range graph.backing.arcs(v) {
+				if !inTree[w] && (!t.reached[w] || weight < t.distance[w]) {
+					t.distance[w], t.previous[w], t.reached[w] = weight, v, true
+					queue.push(w, weight)
+				}
+			}
+		}
+	}
+	return forest, nil
+}
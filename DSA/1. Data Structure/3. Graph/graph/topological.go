@@ -0,0 +1,154 @@
+package graph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"stackqueue/queue"
+)
+
+// CycleError is returned by the topological sorts of a graph with a cycle, Cycle lists the vertices along one cycle
+type CycleError[V comparable] struct {
+	Cycle []V
+}
+
+func (err *CycleError[V]) Error() string {
+	return formatCycle(ErrCycle, err.Cycle)
+}
+
+// Unwrap makes errors.Is(err, ErrCycle) true
+func (err *CycleError[V]) Unwrap() error {
+	return ErrCycle
+}
+
+// formatCycle returns the message of sentinel followed by the vertices of cycle, back to the first one
+func formatCycle[V comparable](sentinel error, cycle []V) string {
+	var text strings.Builder
+	text.WriteString(sentinel.Error())
+	for i, v := range cycle {
+		if i == 0 {
+			fmt.Fprintf(&text, ": %v", v)
+		} else {
+			fmt.Fprintf(&text, " -> %v", v)
+		}
+	}
+	if len(cycle) > 0 {
+		fmt.Fprintf(&text, " -> %v", cycle[0])
+	}
+	return text.String()
+}
+
+/*
+TopologicalSortKahn returns the vertices in an order where every edge goes from an earlier to a later vertex
+It repeatedly takes a vertex without incoming edges from a queue and removes its outgoing edges, O(V + E)
+If vertices are left when the queue runs dry they all have an incoming edge from another leftover vertex,
+so walking those edges backwards must run into a cycle, which is returned in a *CycleError
+An undirected graph returns ErrUndirected
+*/
+func (graph *Graph[V, W]) TopologicalSortKahn() ([]V, error) {
+	if !graph.directed {
+		return nil, ErrUndirected
+	}
+
+	in := graph.predecessors()
+	degree := make([]int, len(graph.vertices))
+	var ready queue.Queue[int]
+	for v := range graph.vertices {
+		degree[v] = len(in[v])
+		if degree[v] == 0 {
+			ready.Enqueue(v)
+		}
+	}
+
+	order := make([]int, 0, len(graph.vertices))
+	for !ready.IsEmpty() {
+		v, _ := ready.Dequeue()
+		order = append(order, v)
+		for w := range graph.backing.arcs(v) {
+			degree[w]--
+			if degree[w] == 0 {
+				ready.Enqueue(w)
+			}
+		}
+	}
+
+	if len(order) == len(graph.vertices) {
+		return graph.values(order), nil
+	}
+
+	// Walk back from a leftover vertex along edges from leftover vertices until a vertex repeats
+	start := slices.IndexFunc(degree, func(d int) bool { return d > 0 })
+	seen := make(map[int]int)
+	var path []int
+	for v := start; ; {
+		if at, ok := seen[v]; ok {
+			cycle := path[at:]
+			slices.Reverse(cycle)
+			return nil, &CycleError[V]{Cycle: graph.values(cycle)}
+		}
+		seen[v] = len(path)
+		path = append(path, v)
+
+		for _, u := range in[v] {
+			if degree[u] > 0 {
+				v = u
+				break
+			}
+		}
+	}
+}
+
+/*
+TopologicalSortDFS returns the vertices in an order where every edge goes from an earlier to a later vertex
+It runs a DFS from every unvisited vertex and lists the vertices in reverse order of finishing, O(V + E)
+A vertex is grey while it is on the DFS path, so an edge to a grey vertex closes a cycle:
+the part of the path from that vertex on is returned in a *CycleError
+An undirected graph returns ErrUndirected
+*/
+func (graph *Graph[V, W]) TopologicalSortDFS() ([]V, error) {
+	if !graph.directed {
+		return nil, ErrUndirected
+	}
+
+	const (
+		white = iota
+		grey
+		black
+	)
+	color := make([]int, len(graph.vertices))
+	order := make([]int, 0, len(graph.vertices))
+	var path []int
+	var cycle []int
+
+	var visit func(v int) bool
+	visit = func(v int) bool {
+		color[v] = grey
+		path = append(path, v)
+		for w := range graph.backing.arcs(v) {
+			switch color[w] {
+			case grey:
+				cycle = path[slices.Index(path, w):]
+				return false
+			case white:
+				if !visit(w) {
+					return false
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		color[v] = black
+		order = append(order, v)
+		return true
+	}
+
+	for v := range graph.vertices {
+		if color[v] == white && !visit(v) {
+			return nil, &CycleError[V]{Cycle: graph.values(cycle)}
+		}
+	}
+
+	slices.Reverse(order)
+	return graph.values(order), nil
+}
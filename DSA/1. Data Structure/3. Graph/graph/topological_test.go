@@ -0,0 +1,115 @@
+package graph_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"graph/graph"
+)
+
+// checkCycle fails t unless err is a *CycleError whose cycle is a real cycle of g without repeated vertices
+func checkCycle(t *testing.T, name string, g *graph.Graph[int, int], err error) {
+	t.Helper()
+
+	var cycleErr *graph.CycleError[int]
+	if !errors.As(err, &cycleErr) || !errors.Is(err, graph.ErrCycle) {
+		t.Fatalf("%s: got %v, want a *CycleError", name, err)
+	}
+	cycle := cycleErr.Cycle
+	if len(cycle) == 0 {
+		t.Fatalf("%s: the cycle is empty", name)
+	}
+	for i, v := range cycle {
+		next := cycle[(i+1)%len(cycle)]
+		if _, ok := g.Edge(v, next); !ok {
+			t.Fatalf("%s: cycle %v uses the missing edge %d -> %d", name, cycle, v, next)
+		}
+		if slices.Index(cycle, v) != i {
+			t.Fatalf("%s: cycle %v repeats %d", name, cycle, v)
+		}
+	}
+}
+
+// checkOrder fails t unless order lists every vertex of g once and every edge goes forward in it
+func checkOrder(t *testing.T, name string, g *graph.Graph[int, int], order []int) {
+	t.Helper()
+
+	position := make(map[int]int)
+	for i, v := range order {
+		position[v] = i
+	}
+	if len(order) != g.Order() || len(position) != g.Order() {
+		t.Fatalf("%s: order %v does not list the %d vertices once", name, order, g.Order())
+	}
+	for edge := range g.Edges() {
+		if position[edge.From] >= position[edge.To] {
+			t.Fatalf("%s: order %v puts %d before %d against an edge", name, order, edge.To, edge.From)
+		}
+	}
+}
+
+/*
+TestTopologicalSorts checks both sorts on random graphs and on a larger acyclic graph
+A graph has a cycle exactly when the end of some edge reaches its start again (a loop reaches itself),
+then both sorts must return a real cycle, otherwise both must return a valid order
+*/
+func TestTopologicalSorts(t *testing.T) {
+	randomGraphs(t, func(t *testing.T, g *graph.Graph[int, int]) {
+		kahn, kahnErr := g.TopologicalSortKahn()
+		depthFirst, dfsErr := g.TopologicalSortDFS()
+		if !g.Directed() {
+			if !errors.Is(kahnErr, graph.ErrUndirected) || !errors.Is(dfsErr, graph.ErrUndirected) {
+				t.Fatalf("undirected graph: got %v and %v, want %v", kahnErr, dfsErr, graph.ErrUndirected)
+			}
+			return
+		}
+
+		reaches := reachable(g, true)
+		cyclic := false
+		for edge := range g.Edges() {
+			cyclic = cyclic || reaches[edge.To][edge.From]
+		}
+
+		if cyclic {
+			checkCycle(t, "Kahn", g, kahnErr)
+			checkCycle(t, "DFS", g, dfsErr)
+			return
+		}
+		if kahnErr != nil || dfsErr != nil {
+			t.Fatalf("acyclic graph: got %v and %v", kahnErr, dfsErr)
+		}
+		checkOrder(t, "Kahn", g, kahn)
+		checkOrder(t, "DFS", g, depthFirst)
+	})
+
+	// Edges from smaller to larger vertices only can never close a cycle
+	dag := graph.New[int, int](graph.Config{Directed: true})
+	for v := range 30 {
+		dag.AddVertex(v)
+		for w := range v {
+			if (v*7+w*3)%4 == 0 {
+				dag.AddEdge(w, v, 1)
+			}
+		}
+	}
+	for name, sort := range map[string]func() ([]int, error){"Kahn": dag.TopologicalSortKahn, "DFS": dag.TopologicalSortDFS} {
+		order, err := sort()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		checkOrder(t, name, dag, order)
+	}
+}
+
+func TestCycleError(t *testing.T) {
+	g := graph.New[string, int](graph.Config{Directed: true})
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", 1)
+	g.AddEdge("c", "a", 1)
+
+	_, err := g.TopologicalSortDFS()
+	if want := "graph: graph has a cycle: a -> b -> c -> a"; err == nil || err.Error() != want {
+		t.Fatalf("got %v, want %s", err, want)
+	}
+}
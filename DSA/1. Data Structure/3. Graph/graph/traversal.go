@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"iter"
+
+	"stackqueue/queue"
+	"stackqueue/stack"
+)
+
+/*
+BFS returns an iterator over the vertices reachable from start in breadth first order, each with its distance
+in edges from start. It visits every reachable vertex and edge once, O(V + E) with adjacency lists
+If start is not in the graph the iterator yields nothing
+*/
+func (graph *Graph[V, W]) BFS(start V) iter.Seq2[V, int] {
+	return func(yield func(V, int) bool) {
+		first, ok := graph.ids[start]
+		if !ok {
+			return
+		}
+
+		distance := make([]int, len(graph.vertices))
+		for i := range distance {
+			distance[i] = -1
+		}
+		distance[first] = 0
+
+		var pending queue.Queue[int]
+		pending.Enqueue(first)
+		for !pending.IsEmpty() {
+			v, _ := pending.Dequeue()
+			if !yield(graph.vertices[v], distance[v]) {
+				return
+			}
+
+			for w := range graph.backing.arcs(v) {
+				if distance[w] == -1 {
+					distance[w] = distance[v] + 1
+					pending.Enqueue(w)
+				}
+			}
+		}
+	}
+}
+
+/*
+DFS returns an iterator over the vertices reachable from start in depth first preorder
+It keeps an explicit stack instead of recursing, so deep graphs cannot overflow the call stack.
+Neighbors are pushed in reverse, so they are visited in the same order a recursive DFS would visit them
+If start is not in the graph the iterator yields nothing
+*/
+func (graph *Graph[V, W]) DFS(start V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		first, ok := graph.ids[start]
+		if !ok {
+			return
+		}
+
+		visited := make([]bool, len(graph.vertices))
+		var pending stack.Stack[int]
+		var neighbors []int
+		pending.Push(first)
+		for !pending.IsEmpty() {
+			v, _ := pending.Pop()
+			if visited[v] {
+				continue
+			}
+			visited[v] = true
+			if !yield(graph.vertices[v]) {
+				return
+			}
+
+			neighbors = neighbors[:0]
+			for w := range graph.backing.arcs(v) {
+				if !visited[w] {
+					neighbors = append(neighbors, w)
+				}
+			}
+			for i := len(neighbors) - 1; i >= 0; i-- {
+				pending.Push(neighbors[i])
+			}
+		}
+	}
+}
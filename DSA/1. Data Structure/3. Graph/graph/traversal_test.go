@@ -0,0 +1,90 @@
+package graph_test
+
+import (
+	"slices"
+	"testing"
+
+	"graph/graph"
+)
+
+type visit struct {
+	vertex, depth int
+}
+
+// bfs is the reference breadth first search over Neighbors, with a plain slice as the queue
+func bfs(g *graph.Graph[int, int], start int) []visit {
+	depth := map[int]int{start: 0}
+	order := []visit{{start, 0}}
+	for i := 0; i < len(order); i++ {
+		for w := range g.Neighbors(order[i].vertex) {
+			if _, ok := depth[w]; !ok {
+				depth[w] = order[i].depth + 1
+				order = append(order, visit{w, depth[w]})
+			}
+		}
+	}
+	return order
+}
+
+// dfs is the reference recursive depth first search over Neighbors
+func dfs(g *graph.Graph[int, int], v int, visited map[int]bool, order []int) []int {
+	visited[v] = true
+	order = append(order, v)
+	for w := range g.Neighbors(v) {
+		if !visited[w] {
+			order = dfs(g, w, visited, order)
+		}
+	}
+	return order
+}
+
+// TestTraversals checks BFS and DFS from every vertex of random graphs against the reference searches
+func TestTraversals(t *testing.T) {
+	randomGraphs(t, func(t *testing.T, g *graph.Graph[int, int]) {
+		for start := range g.Vertices() {
+			var got []visit
+			for v, depth := range g.BFS(start) {
+				got = append(got, visit{v, depth})
+			}
+			if want := bfs(g, start); !slices.Equal(got, want) {
+				t.Fatalf("BFS(%d) = %v, want %v", start, got, want)
+			}
+
+			if got, want := slices.Collect(g.DFS(start)), dfs(g, start, map[int]bool{}, nil); !slices.Equal(got, want) {
+				t.Fatalf("DFS(%d) = %v, want %v", start, got, want)
+			}
+		}
+	})
+}
+
+func TestTraversalsStop(t *testing.T) {
+	g := graph.New[string, int](graph.Config{Directed: true})
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("a", "c", 1)
+	g.AddEdge("b", "d", 1)
+	g.AddEdge("c", "d", 1)
+
+	var bfsOrder, dfsOrder []string
+	for v, depth := range g.BFS("a") {
+		if depth == 2 {
+			break
+		}
+		bfsOrder = append(bfsOrder, v)
+	}
+	for v := range g.DFS("a") {
+		if v == "c" {
+			break
+		}
+		dfsOrder = append(dfsOrder, v)
+	}
+	if !slices.Equal(bfsOrder, []string{"a", "b", "c"}) || !slices.Equal(dfsOrder, []string{"a", "b", "d"}) {
+		t.Fatalf("got BFS %v and DFS %v before the break", bfsOrder, dfsOrder)
+	}
+
+	for range g.BFS("missing") {
+		t.Fatal("BFS from a missing vertex yielded a vertex")
+	}
+	for range g.DFS("missing") {
+		t.Fatal("DFS from a missing vertex yielded a vertex")
+	}
+}
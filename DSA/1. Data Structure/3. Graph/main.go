@@ -0,0 +1,86 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"graph/graph"
+)
+
+// courses is a prerequisite graph in edge list format, an edge goes from a course to one that needs it
+const courses = `
+# course      needs it
+basics        datastructures
+basics        discrete
+discrete      algorithms
+datastructures algorithms
+algorithms    compilers
+datastructures databases
+`
+
+// network is an undirected graph in DOT format with two triangles joined by a single link
+const network = `
+graph network {
+	// left triangle
+	a -- b -- c -- a;
+	c -- d [weight=5];
+	/* right triangle */
+	d -- e -- f -- d;
+	g;
+}
+`
+
+func main() {
+	prerequisites, err := graph.ReadEdgeList[int](strings.NewReader(courses), graph.Config{Directed: true})
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	fmt.Print("Prerequisites:\n", prerequisites)
+
+	order, _ := prerequisites.TopologicalSortKahn()
+	fmt.Println("Kahn order:", order)
+	order, _ = prerequisites.TopologicalSortDFS()
+	fmt.Println("DFS order: ", order)
+
+	fmt.Print("BFS from basics:")
+	for v, depth := range prerequisites.BFS("basics") {
+		fmt.Printf(" %s(%d)", v, depth)
+	}
+	fmt.Print("\nDFS from basics:")
+	for v := range prerequisites.DFS("basics") {
+		fmt.Print(" ", v)
+	}
+	fmt.Println()
+
+	prerequisites.AddEdge("compilers", "basics", 1)
+	if _, err := prerequisites.TopologicalSortDFS(); errors.Is(err, graph.ErrCycle) {
+		fmt.Println("After adding compilers -> basics:", err)
+	}
+	fmt.Println("Tarjan SCCs:  ", prerequisites.StronglyConnectedTarjan())
+	fmt.Println("Kosaraju SCCs:", prerequisites.StronglyConnectedKosaraju())
+
+	links, err := graph.ReadDOT[int](strings.NewReader(network), graph.AdjacencyMatrix)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	fmt.Print("\nNetwork (adjacency matrix):\n", links)
+	fmt.Println("Components:         ", links.ConnectedComponents())
+	fmt.Println("Bridges:            ", links.Bridges())
+	fmt.Println("Articulation points:", links.ArticulationPoints())
+	_, _, ok := links.Bipartite()
+	fmt.Println("Bipartite:          ", ok)
+
+	square := graph.New[int, int](graph.Config{})
+	for i := 0; i < 4; i++ {
+		square.AddEdge(i, (i+1)%4, 1)
+	}
+	left, right, ok := square.Bipartite()
+	fmt.Println("Square bipartite:   ", ok, left, right)
+
+	fmt.Print("\n", links.ToDOT())
+
+	routingDemo()
+}
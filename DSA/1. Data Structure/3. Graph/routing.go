@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"graph/graph"
+)
+
+// roads is a small road network in edge list format, weights are travel times
+const roads = `
+depot  a  4
+depot  b  1
+b      a  2
+a      c  1
+b      c  5
+c      customer 3
+`
+
+// cableCosts is an undirected graph of the cost to lay a cable between two sites
+const cableCosts = `
+a b 7
+a d 5
+b c 8
+b d 9
+b e 7
+c e 5
+d e 15
+d f 6
+e f 8
+e g 9
+f g 11
+`
+
+// pipeCapacities is a directed network of pipes from s to t, weights are capacities
+const pipeCapacities = `
+s a 10
+s b 5
+a b 15
+a t 5
+b t 10
+`
+
+// cell is a square of a grid map
+type cell struct {
+	x, y int
+}
+
+// routingDemo runs the shortest path, spanning tree and flow algorithms on small graphs
+func routingDemo() {
+	network, err := graph.ReadEdgeList[int](strings.NewReader(roads), graph.Config{Directed: true})
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+
+	fmt.Println("\nRoads:")
+	binary, _ := network.Dijkstra("depot")
+	pairing, _ := network.DijkstraPairing("depot")
+	path, _ := binary.To("customer")
+	fmt.Println("Dijkstra (binary heap): ", path.Vertices, path.Weight)
+	path, _ = pairing.To("customer")
+	fmt.Println("Dijkstra (pairing heap):", path.Vertices, path.Weight)
+
+	all, _ := network.Johnson()
+	path, _ = all.Path("b", "customer")
+	fmt.Println("Johnson b to customer:  ", path.Vertices, path.Weight)
+
+	network.AddEdge("c", "b", -7)
+	if _, err := network.BellmanFord("depot"); err != nil {
+		fmt.Println("After adding c -> b -7: ", err)
+	}
+	if _, err := network.FloydWarshall(); err != nil {
+		fmt.Println("Floyd-Warshall:         ", err)
+	}
+
+	// A 5 x 5 grid with a wall in column 2, only the bottom row gets through
+	grid := graph.New[cell, int](graph.Config{})
+	for x := range 5 {
+		for y := range 5 {
+			if x+1 < 5 && (x+1 != 2 && x != 2 || y == 4) {
+				grid.AddEdge(cell{x, y}, cell{x + 1, y}, 1)
+			}
+			if y+1 < 5 && x != 2 {
+				grid.AddEdge(cell{x, y}, cell{x, y + 1}, 1)
+			}
+		}
+	}
+	target := cell{4, 0}
+	position := func(c cell) (float64, float64) { return float64(c.x), float64(c.y) }
+	path2, _ := grid.AStar(cell{0, 0}, target, graph.ManhattanHeuristic[cell, int](target, position))
+	fmt.Println("\nA* around the wall:", path2.Vertices, path2.Weight)
+
+	cables, _ := graph.ReadEdgeList[int](strings.NewReader(cableCosts), graph.Config{})
+	kruskal, _ := cables.Kruskal()
+	prim, _ := cables.Prim()
+	fmt.Println("\nKruskal:", kruskal.Weight, kruskal.Edges)
+	fmt.Println("Prim:   ", prim.Weight, prim.Edges)
+
+	pipes, _ := graph.ReadEdgeList[int](strings.NewReader(pipeCapacities), graph.Config{Directed: true})
+	karp, _ := pipes.EdmondsKarp("s", "t")
+	dinic, _ := pipes.Dinic("s", "t")
+	fmt.Println("\nEdmonds-Karp:", karp.Value, "paths", karp.Paths, "cut", karp.Cut)
+	fmt.Println("Dinic:       ", dinic.Value, "paths", dinic.Paths, "cut", dinic.Cut)
+}
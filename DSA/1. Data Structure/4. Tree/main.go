@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"tree/tree"
+)
+
+// heightMap is an ordered map that also reports its height, every tree of the tree package is one
+type heightMap[V any] interface {
+	tree.OrderedMap[int, V]
+	Height() int
+}
+
+// kind names a tree and builds empty ones
+type kind[V any] struct {
+	name string
+	new  func() heightMap[V]
+}
+
+// kinds returns every tree of the tree package, in the order the demo prints them
+func kinds[V any]() []kind[V] {
+	return []kind[V]{
+		{"BST", func() heightMap[V] { return tree.NewBST[int, V]() }},
+		{"AVL", func() heightMap[V] { return tree.NewAVL[int, V]() }},
+		{"RedBlack", func() heightMap[V] { return tree.NewRedBlack[int, V]() }},
+		{"Treap", func() heightMap[V] { return tree.NewTreap[int, V]() }},
+	}
+}
+
+func main() {
+	words := []string{"kiwi", "apple", "mango", "cherry", "banana", "fig", "grape", "lemon"}
+	for _, kind := range kinds[string]() {
+		m := kind.new()
+		for i, word := range words {
+			m.Put(i*10, word)
+		}
+		m.Delete(30)
+
+		floorKey, floor, _ := m.Floor(35)
+		ceilingKey, ceiling, _ := m.Ceiling(35)
+		selectKey, selected, _ := m.Select(3)
+		fmt.Printf("%-8s len=%d floor(35)=%d:%s ceiling(35)=%d:%s rank(35)=%d select(3)=%d:%s range[10,50):",
+			kind.name, m.Len(), floorKey, floor, ceilingKey, ceiling, m.Rank(35), selectKey, selected)
+		for k, v := range m.Range(10, 50) {
+			fmt.Printf(" %d:%s", k, v)
+		}
+		fmt.Println()
+	}
+
+	fmt.Println("\nHeights after inserting 1023 keys:")
+	random := rand.New(rand.NewPCG(1, 2))
+	shuffled := random.Perm(1023)
+	for _, kind := range kinds[struct{}]() {
+		sorted, randomOrder := kind.new(), kind.new()
+		for k := range 1023 {
+			sorted.Put(k, struct{}{})
+			randomOrder.Put(shuffled[k], struct{}{})
+		}
+		fmt.Printf("%-8s sorted=%-5d random=%d\n", kind.name, sorted.Height(), randomOrder.Height())
+	}
+}
@@ -0,0 +1,105 @@
+package tree
+
+import "cmp"
+
+/*
+BST is a plain binary search tree that never rebalances
+Its shape depends on the order of the insertions: random keys give a height of about 2 ln n,
+but sorted keys build a path, and every operation becomes O(n) like in a linked list
+*/
+type BST[K, V any] struct {
+	base[K, V]
+}
+
+// NewBST returns an empty BST of an ordered key type that uses cmp.Compare
+func NewBST[K cmp.Ordered, V any]() *BST[K, V] {
+	return NewBSTFunc[K, V](cmp.Compare[K])
+}
+
+// NewBSTFunc returns an empty BST that orders its keys with the given compare function
+func NewBSTFunc[K, V any](compare func(a, b K) int) *BST[K, V] {
+	return &BST[K, V]{base[K, V]{compare: compare}}
+}
+
+// Put stores value for key and reports whether the key is new, a new key becomes a leaf where the search ends
+func (tree *BST[K, V]) Put(key K, value V) bool {
+	var isNew bool
+	tree.root, isNew = tree.put(tree.root, key, value)
+	return isNew
+}
+
+func (tree *BST[K, V]) put(n *node[K, V], key K, value V) (*node[K, V], bool) {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, size: 1}, true
+	}
+
+	var isNew bool
+	switch c := tree.compare(key, n.key); {
+	case c < 0:
+		n.left, isNew = tree.put(n.left, key, value)
+	case c > 0:
+		n.right, isNew = tree.put(n.right, key, value)
+	default:
+		n.value = value
+	}
+
+	if isNew {
+		n.size++
+	}
+	return n, isNew
+}
+
+/*
+Delete removes key and reports whether it was present
+A node with one child is replaced by that child. A node with two children is replaced by its successor,
+the smallest node of its right subtree, which is unlinked first (Hibbard deletion)
+*/
+func (tree *BST[K, V]) Delete(key K) bool {
+	var deleted bool
+	tree.root, deleted = tree.delete(tree.root, key)
+	return deleted
+}
+
+func (tree *BST[K, V]) delete(n *node[K, V], key K) (*node[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var deleted bool
+	switch c := tree.compare(key, n.key); {
+	case c < 0:
+		n.left, deleted = tree.delete(n.left, key)
+	case c > 0:
+		n.right, deleted = tree.delete(n.right, key)
+	case n.left == nil:
+		return n.right, true
+	case n.right == nil:
+		return n.left, true
+	default:
+		successor := minimum(n.right)
+		successor.right = deleteMin(n.right)
+		successor.left = n.left
+		resize(successor)
+		return successor, true
+	}
+
+	if deleted {
+		n.size--
+	}
+	return n, deleted
+}
+
+// deleteMin unlinks the smallest node of the subtree of n and returns the new subtree
+func deleteMin[K, V any](n *node[K, V]) *node[K, V] {
+	if n.left == nil {
+		return n.right
+	}
+	n.left = deleteMin(n.left)
+	n.size--
+	return n
+}
+
+// Check returns an error wrapping ErrInvariant if a key is out of order or a subtree size is wrong
+func (tree *BST[K, V]) Check() error {
+	return tree.check(nil)
+}
@@ -0,0 +1,6 @@
+package tree
+
+import "errors"
+
+// ErrInvariant is wrapped by the errors the Check methods return when a tree is not in a valid shape
+var ErrInvariant = errors.New("tree: invariant violated")
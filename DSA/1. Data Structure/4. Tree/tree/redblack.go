@@ -0,0 +1,217 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+)
+
+/*
+RedBlack is a left-leaning red-black tree, Sedgewick's simpler version of the red-black tree
+A red link glues a node to its parent into one 2-3 tree node, so the tree mirrors a 2-3 tree where every leaf
+has the same depth. Red links only lean left and never come two in a row, which bounds the height by 2 log n
+Put and Delete restore that on the way back up with rotations and color flips
+*/
+type RedBlack[K, V any] struct {
+	base[K, V]
+}
+
+// NewRedBlack returns an empty left-leaning red-black tree of an ordered key type that uses cmp.Compare
+func NewRedBlack[K cmp.Ordered, V any]() *RedBlack[K, V] {
+	return NewRedBlackFunc[K, V](cmp.Compare[K])
+}
+
+// NewRedBlackFunc returns an empty left-leaning red-black tree that orders its keys with the given compare function
+func NewRedBlackFunc[K, V any](compare func(a, b K) int) *RedBlack[K, V] {
+	return &RedBlack[K, V]{base[K, V]{compare: compare}}
+}
+
+// isRed reports whether the link from its parent to n is red, nil links are black
+func isRed[K, V any](n *node[K, V]) bool {
+	return n != nil && n.red
+}
+
+// rotateLeftRed rotates a right leaning red link to the left, the new top keeps the color of the old one
+func rotateLeftRed[K, V any](n *node[K, V]) *node[K, V] {
+	x := rotateLeft(n)
+	x.red, n.red = n.red, true
+	return x
+}
+
+// rotateRightRed rotates a left leaning red link to the right, the new top keeps the color of the old one
+func rotateRightRed[K, V any](n *node[K, V]) *node[K, V] {
+	x := rotateRight(n)
+	x.red, n.red = n.red, true
+	return x
+}
+
+// flipColors splits a temporary 4-node (both links red) or, in reverse, combines three nodes into one
+func flipColors[K, V any](n *node[K, V]) {
+	n.red = !n.red
+	n.left.red = !n.left.red
+	n.right.red = !n.right.red
+}
+
+// fixUp restores the left-leaning invariants at n on the way back up and recomputes its size
+func fixUp[K, V any](n *node[K, V]) *node[K, V] {
+	if isRed(n.right) && !isRed(n.left) {
+		n = rotateLeftRed(n)
+	}
+	if isRed(n.left) && isRed(n.left.left) {
+		n = rotateRightRed(n)
+	}
+	if isRed(n.left) && isRed(n.right) {
+		flipColors(n)
+	}
+	resize(n)
+	return n
+}
+
+// Put stores value for key and reports whether the key is new, a new key joins its parent with a red link
+func (tree *RedBlack[K, V]) Put(key K, value V) bool {
+	var isNew bool
+	tree.root, isNew = tree.put(tree.root, key, value)
+	tree.root.red = false
+	return isNew
+}
+
+func (tree *RedBlack[K, V]) put(n *node[K, V], key K, value V) (*node[K, V], bool) {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, size: 1, red: true}, true
+	}
+
+	var isNew bool
+	switch c := tree.compare(key, n.key); {
+	case c < 0:
+		n.left, isNew = tree.put(n.left, key, value)
+	case c > 0:
+		n.right, isNew = tree.put(n.right, key, value)
+	default:
+		n.value = value
+	}
+	return fixUp(n), isNew
+}
+
+/*
+Delete removes key and reports whether it was present
+On the way down it makes sure the current node is not a 2-node, borrowing from a sibling (moveRedLeft, moveRedRight),
+so the key can be removed from the bottom without breaking the black balance. fixUp cleans up on the way back
+*/
+func (tree *RedBlack[K, V]) Delete(key K) bool {
+	if _, ok := tree.Get(key); !ok {
+		return false
+	}
+
+	if !isRed(tree.root.left) && !isRed(tree.root.right) {
+		tree.root.red = true
+	}
+	tree.root = tree.delete(tree.root, key)
+	if tree.root != nil {
+		tree.root.red = false
+	}
+	return true
+}
+
+func (tree *RedBlack[K, V]) delete(n *node[K, V], key K) *node[K, V] {
+	if tree.compare(key, n.key) < 0 {
+		if !isRed(n.left) && !isRed(n.left.left) {
+			n = moveRedLeft(n)
+		}
+		n.left = tree.delete(n.left, key)
+		return fixUp(n)
+	}
+
+	if isRed(n.left) {
+		n = rotateRightRed(n)
+	}
+	if tree.compare(key, n.key) == 0 && n.right == nil {
+		return nil
+	}
+	if !isRed(n.right) && !isRed(n.right.left) {
+		n = moveRedRight(n)
+	}
+	if tree.compare(key, n.key) == 0 {
+		successor := minimum(n.right)
+		n.key, n.value = successor.key, successor.value
+		n.right = deleteMinRed(n.right)
+	} else {
+		n.right = tree.delete(n.right, key)
+	}
+	return fixUp(n)
+}
+
+// deleteMinRed unlinks the smallest node of the subtree of n, keeping the current node out of 2-nodes like delete
+func deleteMinRed[K, V any](n *node[K, V]) *node[K, V] {
+	if n.left == nil {
+		return nil
+	}
+	if !isRed(n.left) && !isRed(n.left.left) {
+		n = moveRedLeft(n)
+	}
+	n.left = deleteMinRed(n.left)
+	return fixUp(n)
+}
+
+// moveRedLeft makes n.left or one of its children red, borrowing a node from the right sibling if it can
+func moveRedLeft[K, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.right.left) {
+		n.right = rotateRightRed(n.right)
+		n = rotateLeftRed(n)
+		flipColors(n)
+	}
+	return n
+}
+
+// moveRedRight makes n.right or one of its children red, borrowing a node from the left sibling if it can
+func moveRedRight[K, V any](n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.left.left) {
+		n = rotateRightRed(n)
+		flipColors(n)
+	}
+	return n
+}
+
+/*
+Check returns an error wrapping ErrInvariant if a key is out of order, a size is wrong, the root is red,
+a red link leans right, two red links follow each other or two paths from the root have a different number of black links
+*/
+func (tree *RedBlack[K, V]) Check() error {
+	if isRed(tree.root) {
+		return fmt.Errorf("%w: the root is red", ErrInvariant)
+	}
+
+	black := -1
+	var depth func(n *node[K, V], blacks int) error
+	depth = func(n *node[K, V], blacks int) error {
+		if n == nil {
+			if black == -1 {
+				black = blacks
+			}
+			if blacks != black {
+				return fmt.Errorf("%w: a path has %d black links, another %d", ErrInvariant, blacks, black)
+			}
+			return nil
+		}
+		if !n.red {
+			blacks++
+		}
+		if err := depth(n.left, blacks); err != nil {
+			return err
+		}
+		return depth(n.right, blacks)
+	}
+	if err := depth(tree.root, 0); err != nil {
+		return err
+	}
+
+	return tree.check(func(n *node[K, V]) error {
+		if isRed(n.right) {
+			return fmt.Errorf("%w: node %v has a red right link", ErrInvariant, n.key)
+		}
+		if isRed(n) && isRed(n.left) {
+			return fmt.Errorf("%w: node %v and its left child are both red", ErrInvariant, n.key)
+		}
+		return nil
+	})
+}
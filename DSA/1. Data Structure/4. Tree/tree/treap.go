@@ -0,0 +1,141 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+)
+
+/*
+Treap is a binary search tree on the keys and at the same time a heap on random priorities
+Every node gets a random priority and sits above all nodes with lower priorities, so the shape is the one
+a plain BST would get from inserting the keys in random order: expected height O(log n) whatever the real order
+Updates use split and merge instead of rotations
+*/
+type Treap[K, V any] struct {
+	base[K, V]
+}
+
+// NewTreap returns an empty treap of an ordered key type that uses cmp.Compare
+func NewTreap[K cmp.Ordered, V any]() *Treap[K, V] {
+	return NewTreapFunc[K, V](cmp.Compare[K])
+}
+
+// NewTreapFunc returns an empty treap that orders its keys with the given compare function
+func NewTreapFunc[K, V any](compare func(a, b K) int) *Treap[K, V] {
+	return &Treap[K, V]{base[K, V]{compare: compare}}
+}
+
+/*
+Put stores value for key and reports whether the key is new
+A new node goes down until it meets a node with a lower priority, splits that subtree around its key and takes its place
+*/
+func (tree *Treap[K, V]) Put(key K, value V) bool {
+	for n := tree.root; n != nil; {
+		switch c := tree.compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			n.value = value
+			return false
+		}
+	}
+
+	tree.root = tree.insert(tree.root, &node[K, V]{key: key, value: value, size: 1, priority: rand.Uint32()})
+	return true
+}
+
+func (tree *Treap[K, V]) insert(n, inserted *node[K, V]) *node[K, V] {
+	if n == nil {
+		return inserted
+	}
+	if inserted.priority > n.priority {
+		inserted.left, inserted.right = tree.split(n, inserted.key)
+		resize(inserted)
+		return inserted
+	}
+
+	if tree.compare(inserted.key, n.key) < 0 {
+		n.left = tree.insert(n.left, inserted)
+	} else {
+		n.right = tree.insert(n.right, inserted)
+	}
+	n.size++
+	return n
+}
+
+// split cuts the subtree of n into the keys smaller than key and the keys larger than key, key itself must be absent
+func (tree *Treap[K, V]) split(n *node[K, V], key K) (smaller, larger *node[K, V]) {
+	if n == nil {
+		return nil, nil
+	}
+
+	if tree.compare(n.key, key) < 0 {
+		n.right, larger = tree.split(n.right, key)
+		resize(n)
+		return n, larger
+	}
+	smaller, n.left = tree.split(n.left, key)
+	resize(n)
+	return smaller, n
+}
+
+// merge joins two treaps where every key of smaller is below every key of larger, the higher priority root goes on top
+func merge[K, V any](smaller, larger *node[K, V]) *node[K, V] {
+	switch {
+	case smaller == nil:
+		return larger
+	case larger == nil:
+		return smaller
+	case smaller.priority > larger.priority:
+		smaller.right = merge(smaller.right, larger)
+		resize(smaller)
+		return smaller
+	default:
+		larger.left = merge(smaller, larger.left)
+		resize(larger)
+		return larger
+	}
+}
+
+// Delete removes key and reports whether it was present, the node is replaced by the merge of its two subtrees
+func (tree *Treap[K, V]) Delete(key K) bool {
+	var deleted bool
+	tree.root, deleted = tree.delete(tree.root, key)
+	return deleted
+}
+
+func (tree *Treap[K, V]) delete(n *node[K, V], key K) (*node[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var deleted bool
+	switch c := tree.compare(key, n.key); {
+	case c < 0:
+		n.left, deleted = tree.delete(n.left, key)
+	case c > 0:
+		n.right, deleted = tree.delete(n.right, key)
+	default:
+		return merge(n.left, n.right), true
+	}
+
+	if deleted {
+		n.size--
+	}
+	return n, deleted
+}
+
+// Check returns an error wrapping ErrInvariant if a key is out of order, a size is wrong or a child has a higher priority
+func (tree *Treap[K, V]) Check() error {
+	return tree.check(func(n *node[K, V]) error {
+		for _, child := range []*node[K, V]{n.left, n.right} {
+			if child != nil && child.priority > n.priority {
+				return fmt.Errorf("%w: node %v has a child with a higher priority", ErrInvariant, n.key)
+			}
+		}
+		return nil
+	})
+}
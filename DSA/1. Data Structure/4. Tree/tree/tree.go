@@ -0,0 +1,297 @@
+/*
+Package tree provides generic ordered maps built on binary search trees
+A binary search tree keeps every key in its left subtree smaller and every key in its right subtree larger,
+so a search follows one path from the root. BST does nothing more and degrades to a linked list on sorted input,
+AVL, RedBlack (left-leaning red-black) and Treap each keep the tree balanced in their own way, so every operation
+is O(log n). They all implement OrderedMap and every node stores the size of its subtree, which makes Rank and Select
+O(height) as well
+*/
+package tree
+
+import (
+	"fmt"
+	"iter"
+)
+
+/*
+OrderedMap is a map from K to V that keeps its keys in order
+Put reports whether the key is new and Delete whether it was present
+Floor returns the largest key <= key and Ceiling the smallest key >= key
+Rank returns the number of keys smaller than key and Select the key with the given rank (0 based)
+Range iterates over the keys k with from <= k < to and All over every key, both in ascending order
+*/
+type OrderedMap[K, V any] interface {
+	Len() int
+	Get(key K) (V, bool)
+	Put(key K, value V) bool
+	Delete(key K) bool
+	Min() (K, V, bool)
+	Max() (K, V, bool)
+	Floor(key K) (K, V, bool)
+	Ceiling(key K) (K, V, bool)
+	Rank(key K) int
+	Select(rank int) (K, V, bool)
+	Range(from, to K) iter.Seq2[K, V]
+	All() iter.Seq2[K, V]
+}
+
+/*
+node is a node of any of the trees, size is the number of nodes in its subtree
+Each balanced tree uses one more field for its bookkeeping: height for AVL, red for RedBlack and priority for Treap
+*/
+type node[K, V any] struct {
+	key         K
+	value       V
+	left, right *node[K, V]
+	size        int
+	height      int
+	red         bool
+	priority    uint32
+}
+
+// size returns the number of nodes in the subtree of n, 0 for nil
+func size[K, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// resize recomputes the size of n from its children
+func resize[K, V any](n *node[K, V]) {
+	n.size = 1 + size(n.left) + size(n.right)
+}
+
+/*
+base holds the root and the compare function and implements the read only half of OrderedMap,
+every tree embeds it and adds Put, Delete and Check. All of these are O(height)
+*/
+type base[K, V any] struct {
+	root    *node[K, V]
+	compare func(a, b K) int
+}
+
+// Len returns the number of keys
+func (tree *base[K, V]) Len() int {
+	return size(tree.root)
+}
+
+// Get returns the value stored for key
+func (tree *base[K, V]) Get(key K) (V, bool) {
+	n := tree.root
+	for n != nil {
+		switch c := tree.compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Min returns the smallest key and its value
+func (tree *base[K, V]) Min() (K, V, bool) {
+	return result(minimum(tree.root))
+}
+
+// Max returns the largest key and its value
+func (tree *base[K, V]) Max() (K, V, bool) {
+	return result(maximum(tree.root))
+}
+
+// Floor returns the largest key <= key: going right past a smaller key remembers it as the best so far
+func (tree *base[K, V]) Floor(key K) (K, V, bool) {
+	var best *node[K, V]
+	for n := tree.root; n != nil; {
+		switch c := tree.compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			best, n = n, n.right
+		default:
+			return result(n)
+		}
+	}
+	return result(best)
+}
+
+// Ceiling returns the smallest key >= key, the mirror image of Floor
+func (tree *base[K, V]) Ceiling(key K) (K, V, bool) {
+	var best *node[K, V]
+	for n := tree.root; n != nil; {
+		switch c := tree.compare(key, n.key); {
+		case c < 0:
+			best, n = n, n.left
+		case c > 0:
+			n = n.right
+		default:
+			return result(n)
+		}
+	}
+	return result(best)
+}
+
+// Rank returns the number of keys smaller than key: every time the search goes right, the left subtree and the node are smaller
+func (tree *base[K, V]) Rank(key K) int {
+	rank := 0
+	for n := tree.root; n != nil; {
+		switch c := tree.compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			rank += size(n.left) + 1
+			n = n.right
+		default:
+			return rank + size(n.left)
+		}
+	}
+	return rank
+}
+
+// Select returns the key with rank smaller keys, the left subtree sizes tell which way to go
+func (tree *base[K, V]) Select(rank int) (K, V, bool) {
+	n := tree.root
+	for n != nil {
+		switch left := size(n.left); {
+		case rank < left:
+			n = n.left
+		case rank > left:
+			rank -= left + 1
+			n = n.right
+		default:
+			return result(n)
+		}
+	}
+	return result(n)
+}
+
+// Range returns an iterator over the keys k with from <= k < to in ascending order, it skips the subtrees out of range
+func (tree *base[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var walk func(n *node[K, V]) bool
+		walk = func(n *node[K, V]) bool {
+			if n == nil {
+				return true
+			}
+			afterFrom := tree.compare(n.key, from) >= 0
+			beforeTo := tree.compare(n.key, to) < 0
+			if afterFrom && !walk(n.left) {
+				return false
+			}
+			if afterFrom && beforeTo && !yield(n.key, n.value) {
+				return false
+			}
+			return !beforeTo || walk(n.right)
+		}
+		walk(tree.root)
+	}
+}
+
+// All returns an iterator over every key in ascending order, it keeps the path to the current node on a stack
+func (tree *base[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var path []*node[K, V]
+		for n := tree.root; n != nil || len(path) > 0; n = n.right {
+			for ; n != nil; n = n.left {
+				path = append(path, n)
+			}
+			n = path[len(path)-1]
+			path = path[:len(path)-1]
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Height returns the number of nodes on the longest path from the root down, it walks the whole tree in O(n)
+func (tree *base[K, V]) Height() int {
+	var height func(n *node[K, V]) int
+	height = func(n *node[K, V]) int {
+		if n == nil {
+			return 0
+		}
+		return 1 + max(height(n.left), height(n.right))
+	}
+	return height(tree.root)
+}
+
+// result unpacks n into a key, a value and whether n is not nil
+func result[K, V any](n *node[K, V]) (K, V, bool) {
+	if n == nil {
+		var key K
+		var value V
+		return key, value, false
+	}
+	return n.key, n.value, true
+}
+
+func minimum[K, V any](n *node[K, V]) *node[K, V] {
+	for n != nil && n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func maximum[K, V any](n *node[K, V]) *node[K, V] {
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+/*
+rotateLeft turns the link from n to its right child x around: x takes the place of n and n becomes its left child
+The keys stay in order and x takes over the size of n, the balanced trees fix their own field afterwards
+*/
+func rotateLeft[K, V any](n *node[K, V]) *node[K, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	x.size = n.size
+	resize(n)
+	return x
+}
+
+// rotateRight is the mirror image of rotateLeft
+func rotateRight[K, V any](n *node[K, V]) *node[K, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	x.size = n.size
+	resize(n)
+	return x
+}
+
+/*
+check walks the tree and returns an error if a key is out of order or a size is wrong
+every is called on each node to check the invariant of a particular tree
+*/
+func (tree *base[K, V]) check(every func(n *node[K, V]) error) error {
+	var walk func(n, low, high *node[K, V]) error
+	walk = func(n, low, high *node[K, V]) error {
+		if n == nil {
+			return nil
+		}
+		if low != nil && tree.compare(n.key, low.key) <= 0 || high != nil && tree.compare(n.key, high.key) >= 0 {
+			return fmt.Errorf("%w: key %v is out of order", ErrInvariant, n.key)
+		}
+		if n.size != 1+size(n.left)+size(n.right) {
+			return fmt.Errorf("%w: node %v has size %d, want %d", ErrInvariant, n.key, n.size, 1+size(n.left)+size(n.right))
+		}
+		if every != nil {
+			if err := every(n); err != nil {
+				return err
+			}
+		}
+		if err := walk(n.left, low, n); err != nil {
+			return err
+		}
+		return walk(n.right, n, high)
+	}
+	return walk(tree.root, nil, nil)
+}
@@ -0,0 +1,331 @@
+package tree_test
+
+import (
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"generic/linkedlist"
+	"tree/tree"
+)
+
+// factories build every tree of the package
+var factories = []struct {
+	name    string
+	factory newMap
+}{
+	{"BST", func() orderedMap { return tree.NewBST[int, int]() }},
+	{"AVL", func() orderedMap { return tree.NewAVL[int, int]() }},
+	{"RedBlack", func() orderedMap { return tree.NewRedBlack[int, int]() }},
+	{"Treap", func() orderedMap { return tree.NewTreap[int, int]() }},
+}
+
+/*
+TestTrees checks every tree by running programs of map operations against a tree and against a reference model (a built-in
+map whose keys are sorted on demand), and after every step checks that both agree: the returned values, Len, the keys
+and values from All, and the invariants of the tree as reported by its Check method
+A program is a byte string where every three bytes are one step (the operation, a key and a value),
+so the same programs can come from a table, from a random generator or from the fuzzer
+*/
+func TestTrees(t *testing.T) {
+	for _, f := range factories {
+		t.Run(f.name, func(t *testing.T) { run(t, f.factory) })
+	}
+}
+
+func FuzzBST(f *testing.F)      { fuzz(f, factories[0].factory) }
+func FuzzAVL(f *testing.F)      { fuzz(f, factories[1].factory) }
+func FuzzRedBlack(f *testing.F) { fuzz(f, factories[2].factory) }
+func FuzzTreap(f *testing.F)    { fuzz(f, factories[3].factory) }
+
+// orderedMap is an ordered map of ints that can check its own invariants, every tree of the tree package is one
+type orderedMap interface {
+	tree.OrderedMap[int, int]
+	Check() error
+}
+
+// newMap returns a new empty map for one program
+type newMap func() orderedMap
+
+// seeds are the programs run always checks and fuzz starts from, each one covers an edge case
+var seeds = map[string][]byte{
+	"Ascending":       {ascending, 0, 40, ascending, 20, 40, query, 5, 0},
+	"Descending":      {descending, 39, 40, descending, 19, 40, query, 5, 0},
+	"DeleteRoot":      {put, 2, 0, put, 1, 0, put, 3, 0, remove, 2, 0, remove, 1, 0, remove, 3, 0, put, 2, 0},
+	"DeleteMissing":   {remove, 1, 0, put, 1, 0, remove, 2, 0, remove, 1, 0, remove, 1, 0},
+	"DeleteAll":       {ascending, 0, 40, removeRange, 0, 40, ascending, 0, 10},
+	"Overwrite":       {put, 1, 1, put, 1, 2, put, 1, 3, query, 1, 0},
+	"EmptyQueries":    {query, 0, 0, rangeQuery, 0, 40, selectQuery, 0, 0},
+	"RangeEmptyBound": {ascending, 10, 10, rangeQuery, 15, 15, rangeQuery, 20, 5, rangeQuery, 0, 40},
+	"ZigZag":          {put, 20, 0, put, 10, 0, put, 15, 0, put, 30, 0, put, 25, 0, remove, 20, 0, remove, 15, 0},
+}
+
+// The operations a program step can run, the operation byte picks one modulo operationCount
+const (
+	put = iota
+	remove
+	query
+	selectQuery
+	rangeQuery
+	ascending
+	descending
+	removeRange
+	operationCount
+)
+
+// names are the names of the operations in failure messages
+var names = [operationCount]string{"Put", "Delete", "Query", "Select", "Range", "Ascending", "Descending", "DeleteRange"}
+
+/*
+run checks the map returned by factory against the model
+It runs every program in seeds and a fixed set of random programs, each one as its own subtest
+*/
+func run(t *testing.T, factory newMap) {
+	for name, program := range seeds {
+		t.Run(name, func(t *testing.T) {
+			check(t, factory(), program)
+		})
+	}
+
+	random := rand.New(rand.NewPCG(1, 2))
+	for i := range 200 {
+		program := make([]byte, 3*random.IntN(96))
+		for j := range program {
+			program[j] = byte(random.UintN(256))
+		}
+
+		t.Run(fmt.Sprintf("Random%d", i), func(t *testing.T) {
+			check(t, factory(), program)
+		})
+	}
+}
+
+// fuzz adds seeds to the corpus of f and fuzzes programs against the map returned by factory
+func fuzz(f *testing.F, factory newMap) {
+	for _, program := range seeds {
+		f.Add(program)
+	}
+
+	f.Fuzz(func(t *testing.T, program []byte) {
+		check(t, factory(), program)
+	})
+}
+
+// check runs program against m and the model and fails t at the first step where they disagree
+func check(t testing.TB, m orderedMap, program []byte) {
+	t.Helper()
+
+	reference := model{}
+	for i := 0; i+2 < len(program); i += 3 {
+		operation := int(program[i]) % operationCount
+		k, v := key(program[i+1]), int(program[i+2])
+
+		if err := step(m, reference, operation, k, v); err != nil {
+			t.Fatalf("step %d %s(%d, %d): %v", i/3, names[operation], k, v, err)
+		}
+		if err := reference.verify(m); err != nil {
+			t.Fatalf("step %d %s(%d, %d): %v", i/3, names[operation], k, v, err)
+		}
+	}
+}
+
+// key maps an argument byte to a small key, so programs hit existing keys and duplicates often
+func key(b byte) int {
+	return int(b % 48)
+}
+
+// model is the reference implementation, a built-in map
+type model map[int]int
+
+// keys returns the keys of the model in ascending order
+func (reference model) keys() []int {
+	return slices.Sorted(maps.Keys(reference))
+}
+
+// step runs one operation on both m and the model and returns an error if their results differ
+func step(m orderedMap, reference model, operation, k, v int) error {
+	switch operation {
+	case put:
+		_, exists := reference[k]
+		reference[k] = v
+		if got := m.Put(k, v); got == exists {
+			return fmt.Errorf("Put returned %t, want %t", got, !exists)
+		}
+	case remove:
+		_, exists := reference[k]
+		delete(reference, k)
+		if got := m.Delete(k); got != exists {
+			return fmt.Errorf("Delete returned %t, want %t", got, exists)
+		}
+	case query:
+		return reference.query(m, k)
+	case selectQuery:
+		keys := reference.keys()
+		for rank := -1; rank <= len(keys); rank++ {
+			gotKey, gotValue, ok := m.Select(rank)
+			if want := rank >= 0 && rank < len(keys); ok != want {
+				return fmt.Errorf("Select(%d) found %t, want %t", rank, ok, want)
+			}
+			if ok && (gotKey != keys[rank] || gotValue != reference[keys[rank]]) {
+				return fmt.Errorf("Select(%d) = %d, %d, want %d, %d", rank, gotKey, gotValue, keys[rank], reference[keys[rank]])
+			}
+		}
+	case rangeQuery:
+		var want []int
+		for _, each := range reference.keys() {
+			if each >= k && each < k+v%48 {
+				want = append(want, each, reference[each])
+			}
+		}
+		var got []int
+		for each, value := range m.Range(k, k+v%48) {
+			got = append(got, each, value)
+		}
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("Range(%d, %d) = %v, want %v", k, k+v%48, got, want)
+		}
+	case ascending:
+		for each := k; each < k+v%48; each++ {
+			_, exists := reference[each]
+			reference[each] = each
+			if m.Put(each, each) == exists {
+				return fmt.Errorf("Put(%d) reported the wrong result", each)
+			}
+		}
+	case descending:
+		for each := k; each > k-v%48; each-- {
+			_, exists := reference[each]
+			reference[each] = each
+			if m.Put(each, each) == exists {
+				return fmt.Errorf("Put(%d) reported the wrong result", each)
+			}
+		}
+	case removeRange:
+		for each := k; each < k+v%48; each++ {
+			_, exists := reference[each]
+			delete(reference, each)
+			if m.Delete(each) != exists {
+				return fmt.Errorf("Delete(%d) reported the wrong result", each)
+			}
+		}
+	}
+	return nil
+}
+
+// query checks Get, Floor, Ceiling and Rank for k and Min and Max against the model
+func (reference model) query(m orderedMap, k int) error {
+	keys := reference.keys()
+	rank, found := slices.BinarySearch(keys, k)
+
+	value, ok := m.Get(k)
+	if ok != found || ok && value != reference[k] {
+		return fmt.Errorf("Get = %d, %t, want %d, %t", value, ok, reference[k], found)
+	}
+	if got := m.Rank(k); got != rank {
+		return fmt.Errorf("Rank = %d, want %d", got, rank)
+	}
+
+	floor := rank - 1
+	if found {
+		floor = rank
+	}
+	if err := reference.expect("Floor", keys, floor)(m.Floor(k)); err != nil {
+		return err
+	}
+	if err := reference.expect("Ceiling", keys, rank)(m.Ceiling(k)); err != nil {
+		return err
+	}
+	if err := reference.expect("Min", keys, 0)(m.Min()); err != nil {
+		return err
+	}
+	return reference.expect("Max", keys, len(keys)-1)(m.Max())
+}
+
+// expect returns a check that a lookup named name returned keys[index], or nothing if index is out of range
+func (reference model) expect(name string, keys []int, index int) func(k, v int, ok bool) error {
+	return func(k, v int, ok bool) error {
+		if want := index >= 0 && index < len(keys); ok != want {
+			return fmt.Errorf("%s found %t, want %t", name, ok, want)
+		}
+		if ok && (k != keys[index] || v != reference[keys[index]]) {
+			return fmt.Errorf("%s = %d, %d, want %d, %d", name, k, v, keys[index], reference[keys[index]])
+		}
+		return nil
+	}
+}
+
+// verify checks the invariants, the length and the contents of m against the model
+func (reference model) verify(m orderedMap) error {
+	if err := m.Check(); err != nil {
+		return err
+	}
+	if m.Len() != len(reference) {
+		return fmt.Errorf("Len = %d, want %d", m.Len(), len(reference))
+	}
+
+	var got, want []int
+	for k, v := range m.All() {
+		got = append(got, k, v)
+	}
+	for _, k := range reference.keys() {
+		want = append(want, k, reference[k])
+	}
+	if !slices.Equal(got, want) {
+		return fmt.Errorf("All = %v, want %v", got, want)
+	}
+	return nil
+}
+
+// benchmarkSize is the number of keys every benchmark inserts and then looks up
+const benchmarkSize = 2000
+
+/*
+BenchmarkPutGet inserts benchmarkSize random keys into a new map and then looks every one up, once per iteration
+The built-in map is the unordered baseline, the sorted linked list the ordered one where every insert and lookup walks the list
+*/
+func BenchmarkPutGet(b *testing.B) {
+	keys := rand.New(rand.NewPCG(1, 2)).Perm(benchmarkSize)
+
+	b.Run("map", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			m := make(map[int]int)
+			for _, k := range keys {
+				m[k] = k
+			}
+			for _, k := range keys {
+				_ = m[k]
+			}
+		}
+	})
+
+	b.Run("LinkedList", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			list := linkedlist.New[int]()
+			for _, k := range keys {
+				list.InsertInSortedList(k)
+			}
+			for _, k := range keys {
+				list.FindIndexByValue(k)
+			}
+		}
+	})
+
+	for _, f := range factories {
+		b.Run(f.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				m := f.factory()
+				for _, k := range keys {
+					m.Put(k, k)
+				}
+				for _, k := range keys {
+					m.Get(k)
+				}
+			}
+		})
+	}
+}
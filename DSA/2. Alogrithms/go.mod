@@ -0,0 +1,7 @@
+module algorithms
+
+go 1.23.4
+
+require generic v0.0.0
+
+replace generic => "../1. Data Structure/1. Linked List/1. Single Linked List/1. With Generic"
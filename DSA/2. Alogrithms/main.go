@@ -1,9 +1,19 @@
 package main
 
-/*
-TODO : * Need to Tell about Alogrithms
-*/
+import (
+	"fmt"
+
+	"algorithms/sorting"
+)
 
 func main() {
+	algorithms := sorting.IntAlgorithms()
+
+	fmt.Println("Sorting algorithms")
+	fmt.Printf("%-14s %-7s %-8s %s\n", "Name", "Stable", "InPlace", "Worst case")
+	for _, algorithm := range algorithms {
+		fmt.Printf("%-14s %-7t %-8t %s\n", algorithm.Name, algorithm.Stable, algorithm.InPlace, algorithm.Time)
+	}
 
+	searchingDemo()
 }
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+
+	"algorithms/searching"
+	"generic/linkedlist"
+)
+
+// searchingDemo finds one value with every search, the linked list scan first as the baseline, and the median with every selection
+func searchingDemo() {
+	s := []int{1, 3, 3, 3, 8, 13, 21, 34, 55, 89}
+	list := linkedlist.Collect(slices.Values(s))
+	target := 3
+
+	fmt.Printf("\nSearching %d in %v\n", target, s)
+	if i, err := list.FindIndexByValue(target); err == nil {
+		fmt.Printf("%-22s %d\n", "LinkedList.FindIndex", i)
+	}
+
+	searches := []struct {
+		name   string
+		search func(s []int, target int) (int, bool)
+	}{
+		{"Linear", searching.Linear[[]int]},
+		{"Binary (First)", searching.First[[]int]},
+		{"Binary (Last)", searching.Last[[]int]},
+		{"Exponential", searching.Exponential[[]int]},
+		{"Interpolation", searching.Interpolation[[]int]},
+		{"Ternary", searching.Ternary[[]int]},
+	}
+	for _, search := range searches {
+		if i, ok := search.search(s, target); ok {
+			fmt.Printf("%-22s %d\n", search.name, i)
+		}
+	}
+
+	unsorted := []int{55, 3, 89, 21, 1, 34, 8, 3, 13, 3}
+	fmt.Printf("\nMedian of %v\n", unsorted)
+	selections := []struct {
+		name string
+		kth  func(s []int, k int) (int, error)
+	}{
+		{"QuickSelect", searching.QuickSelect[[]int]},
+		{"KthSmallest", searching.KthSmallest[[]int]},
+	}
+	for _, selection := range selections {
+		if median, err := selection.kth(slices.Clone(unsorted), len(unsorted)/2); err == nil {
+			fmt.Printf("%-22s %d\n", selection.name, median)
+		}
+	}
+}
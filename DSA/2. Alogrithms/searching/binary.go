@@ -0,0 +1,72 @@
+package searching
+
+import "cmp"
+
+/*
+FirstTrue returns the smallest i in [0, n) for which pred(i) is true, or n if there is none
+pred must be false for a prefix of [0, n) and true for the rest, every binary search below is built on it
+It halves the range on every call, so it calls pred at most bits.Len(n) times whatever the input
+*/
+func FirstTrue(n int, pred func(i int) bool) int {
+	lo, hi := 0, n
+	for lo < hi {
+		middle := int(uint(lo+hi) >> 1)
+		if pred(middle) {
+			hi = middle
+		} else {
+			lo = middle + 1
+		}
+	}
+	return lo
+}
+
+// LowerBound returns the index of the first value >= target in the sorted slice s, see LowerBoundFunc
+func LowerBound[S ~[]E, E cmp.Ordered](s S, target E) int {
+	return LowerBoundFunc(s, target, cmp.Compare[E])
+}
+
+// LowerBoundFunc returns the index of the first value not less than target, or len(s), with at most bits.Len(n) comparisons
+func LowerBoundFunc[S ~[]E, E, T any](s S, target T, compare func(v E, target T) int) int {
+	return FirstTrue(len(s), func(i int) bool { return compare(s[i], target) >= 0 })
+}
+
+// UpperBound returns the index of the first value > target in the sorted slice s, see UpperBoundFunc
+func UpperBound[S ~[]E, E cmp.Ordered](s S, target E) int {
+	return UpperBoundFunc(s, target, cmp.Compare[E])
+}
+
+// UpperBoundFunc returns the index of the first value greater than target, or len(s), with at most bits.Len(n) comparisons
+func UpperBoundFunc[S ~[]E, E, T any](s S, target T, compare func(v E, target T) int) int {
+	return FirstTrue(len(s), func(i int) bool { return compare(s[i], target) > 0 })
+}
+
+// First returns the index of the first occurrence of target in the sorted slice s, see FirstFunc
+func First[S ~[]E, E cmp.Ordered](s S, target E) (int, bool) {
+	return FirstFunc(s, target, cmp.Compare[E])
+}
+
+/*
+FirstFunc returns the index of the first value equal to target, or -1 and false
+It is LowerBoundFunc plus one comparison, so a run of duplicates does not make it slower
+*/
+func FirstFunc[S ~[]E, E, T any](s S, target T, compare func(v E, target T) int) (int, bool) {
+	i := LowerBoundFunc(s, target, compare)
+	if i < len(s) && compare(s[i], target) == 0 {
+		return i, true
+	}
+	return -1, false
+}
+
+// Last returns the index of the last occurrence of target in the sorted slice s, see LastFunc
+func Last[S ~[]E, E cmp.Ordered](s S, target E) (int, bool) {
+	return LastFunc(s, target, cmp.Compare[E])
+}
+
+// LastFunc returns the index of the last value equal to target, or -1 and false, with at most bits.Len(n) + 1 comparisons
+func LastFunc[S ~[]E, E, T any](s S, target T, compare func(v E, target T) int) (int, bool) {
+	i := UpperBoundFunc(s, target, compare) - 1
+	if i >= 0 && compare(s[i], target) == 0 {
+		return i, true
+	}
+	return -1, false
+}
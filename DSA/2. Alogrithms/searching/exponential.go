@@ -0,0 +1,78 @@
+package searching
+
+import (
+	"cmp"
+	"math"
+)
+
+// Exponential returns the index of the first occurrence of target in the sorted slice s, see ExponentialFunc
+func Exponential[S ~[]E, E cmp.Ordered](s S, target E) (int, bool) {
+	return ExponentialFunc(s, target, cmp.Compare[E])
+}
+
+/*
+ExponentialFunc returns the index of the first value equal to target, or -1 and false
+It probes s[1], s[2], s[4], ... until it passes target and then binary searches the last gap,
+so it costs about 2 log i comparisons where i is the answer: cheaper than binary search near the front,
+and within a factor of 2 of it at the back. It also works when only a prefix of s is known to be sorted
+*/
+func ExponentialFunc[S ~[]E, E, T any](s S, target T, compare func(v E, target T) int) (int, bool) {
+	bound := 1
+	for bound < len(s) && compare(s[bound], target) < 0 {
+		bound *= 2
+	}
+
+	lo := bound / 2
+	hi := min(bound+1, len(s))
+	i := lo + LowerBoundFunc(s[lo:hi], target, compare)
+	if i < len(s) && compare(s[i], target) == 0 {
+		return i, true
+	}
+	return -1, false
+}
+
+// Interpolation returns an index of target in the sorted slice s, see InterpolationFunc
+func Interpolation[S ~[]E, E Number](s S, target E) (int, bool) {
+	return InterpolationFunc(s, target, func(v E) E { return v })
+}
+
+/*
+InterpolationFunc returns an index of a value whose key equals target, or -1 and false
+Instead of the middle it probes where target would be if the keys grew linearly from s[lo] to s[hi]
+On uniformly spread keys that takes O(log log n) probes, but on skewed keys it degrades to O(n):
+with keys 0, 1, ..., n-2 and one huge key at the end every probe lands on lo and removes a single value
+When the estimate is not a number between s[lo] and s[hi], as with infinite keys, it probes the middle like binary search
+A NaN target is never found, NaN keys sort before every number like slices.Sort puts them
+With duplicates the index is of any matching value, use First or Last for a specific one
+*/
+func InterpolationFunc[S ~[]E, E any, K Number](s S, target K, key func(v E) K) (int, bool) {
+	if math.IsNaN(float64(target)) {
+		return -1, false
+	}
+
+	lo, hi := 0, len(s)-1
+	for lo <= hi {
+		low, high := key(s[lo]), key(s[hi])
+		if target < low || target > high {
+			return -1, false
+		}
+		if low == high {
+			return lo, true
+		}
+
+		position := lo + (hi-lo)/2
+		fraction := (float64(target) - float64(low)) / (float64(high) - float64(low))
+		if fraction >= 0 && fraction <= 1 {
+			position = min(max(lo+int(fraction*float64(hi-lo)+0.5), lo), hi)
+		}
+		switch v := key(s[position]); {
+		case v == target:
+			return position, true
+		case v > target:
+			hi = position - 1
+		default:
+			lo = position + 1
+		}
+	}
+	return -1, false
+}
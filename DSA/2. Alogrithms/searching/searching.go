@@ -0,0 +1,41 @@
+/*
+Package searching provides the classic search algorithms over sorted slices and the selection algorithms
+Like the sorting package every function comes in two forms: Name works on a cmp.Ordered type
+and NameFunc takes a compare function, which for the searches compares a slice value with the target
+Every function documents its cost in comparisons, the tests check those bounds on adversarial inputs
+*/
+package searching
+
+import "errors"
+
+// ErrOutOfRange is returned when the k passed to a selection function is not an index of the slice
+var ErrOutOfRange = errors.New("searching: k out of range")
+
+// Number is the set of types Interpolation can compute positions with
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+/*
+Linear returns the index of the first value equal to target, it is the slice version of LinkedList.FindIndexByValue
+It needs no ordering and makes up to n comparisons, so it is the baseline the other searches are measured against
+*/
+func Linear[S ~[]E, E comparable](s S, target E) (int, bool) {
+	for i, v := range s {
+		if v == target {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// LinearFunc returns the index of the first value for which match returns true, making up to n calls
+func LinearFunc[S ~[]E, E any](s S, match func(v E) bool) (int, bool) {
+	for i, v := range s {
+		if match(v) {
+			return i, true
+		}
+	}
+	return -1, false
+}
@@ -0,0 +1,397 @@
+package searching_test
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"algorithms/searching"
+	"algorithms/sorting"
+	"algorithms/sorting/sorttest"
+	"generic/linkedlist"
+)
+
+// sortedInput generates a sorted slice of n ints, random is only used by the inputs that need it
+type sortedInput struct {
+	Name     string
+	Generate func(random *rand.Rand, n int) []int
+}
+
+// inputs are the sorted slices every search is checked and benchmarked on
+var inputs = []sortedInput{
+	{"Uniform", func(_ *rand.Rand, n int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = 2 * i
+		}
+		return s
+	}},
+	{"Duplicates", func(random *rand.Rand, n int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = random.IntN(n/8 + 1)
+		}
+		slices.Sort(s)
+		return s
+	}},
+	{"AllEqual", func(_ *rand.Rand, n int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = 7
+		}
+		return s
+	}},
+	// Skewed is the worst case of interpolation search: every key is tiny compared to the last one
+	{"Skewed", func(_ *rand.Rand, n int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = i
+		}
+		if n > 0 {
+			s[n-1] = 1 << 60
+		}
+		return s
+	}},
+}
+
+// sizes are the input lengths TestSearching checks
+var sizes = []int{0, 1, 2, 3, 10, 100, 1000}
+
+// counter is a compare function that counts its calls
+type counter struct {
+	calls int
+}
+
+func (c *counter) compare(a, b int) int {
+	c.calls++
+	return cmp.Compare(a, b)
+}
+
+// key is an identity key function for interpolation search that counts its calls
+func (c *counter) key(v int) int {
+	c.calls++
+	return v
+}
+
+/*
+TestSearching checks every search on every input and size and every selection on the shapes of sorttest
+Every search runs against a linear scan of the same sorted slice, and every call goes through a compare function
+that counts its calls, so the comparison bounds in the doc comments are checked too, not only the answers
+The inputs include the adversarial ones: long runs of duplicates, a slice of one repeated value and skewed keys
+that push interpolation search to its O(n) worst case
+*/
+func TestSearching(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+	for _, input := range inputs {
+		for _, n := range sizes {
+			s := input.Generate(random, n)
+			t.Run(fmt.Sprintf("Search/%s/%d", input.Name, n), func(t *testing.T) {
+				checkSearch(t, s)
+			})
+		}
+	}
+
+	for _, shape := range sorttest.Shapes {
+		for _, n := range sizes {
+			s := shape.Generate(random, n)
+			t.Run(fmt.Sprintf("Select/%s/%d", shape.Name, n), func(t *testing.T) {
+				checkSelect(t, s)
+			})
+		}
+	}
+
+	for _, n := range sizes {
+		t.Run(fmt.Sprintf("TernaryMax/%d", n), func(t *testing.T) {
+			checkTernaryMax(t, n)
+		})
+	}
+
+	t.Run("InterpolationUniform", func(t *testing.T) {
+		// Evenly spaced keys are the best case, the first probe always lands on the target
+		s := inputs[0].Generate(nil, 1000)
+		for _, target := range s {
+			var c counter
+			searching.InterpolationFunc(s, target, c.key)
+			if c.calls != 3 {
+				t.Fatalf("Interpolation(%d) on uniform keys: %d key calls, want 3", target, c.calls)
+			}
+		}
+	})
+
+	t.Run("InterpolationSkewed", func(t *testing.T) {
+		// The documented worst case: searching the second to last key of Skewed takes a probe per value
+		n := 1000
+		s := inputs[3].Generate(nil, n)
+		var c counter
+		if i, ok := searching.InterpolationFunc(s, n-2, c.key); !ok || i != n-2 {
+			t.Fatalf("Interpolation(%d) on skewed keys = %d, %t", n-2, i, ok)
+		}
+		if c.calls < n {
+			t.Fatalf("Interpolation on skewed keys: %d key calls, want the O(n) worst case of at least %d", c.calls, n)
+		}
+	})
+}
+
+// FuzzSearching checks every search and selection on inputs from the fuzzer, every byte is one value so duplicates are common
+func FuzzSearching(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{1, 1, 1, 2, 9})
+	f.Add([]byte{0, 1, 2, 3, 255})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		s := make([]int, len(data))
+		for i, b := range data {
+			s[i] = int(b)
+		}
+		checkSelect(t, s)
+
+		slices.Sort(s)
+		checkSearch(t, s)
+	})
+}
+
+/*
+checkSearch searches the sorted slice s for every value in it, and the values right next to them, with every search
+It fails t if an answer differs from a linear scan or a search makes more comparisons than its doc comment allows
+*/
+func checkSearch(t testing.TB, s []int) {
+	t.Helper()
+
+	n := len(s)
+	targets := []int{-1}
+	for _, v := range s {
+		targets = append(targets, v-1, v, v+1)
+	}
+
+	for _, target := range targets {
+		lower := len(s)
+		if i, ok := searching.LinearFunc(s, func(v int) bool { return v >= target }); ok {
+			lower = i
+		}
+		upper := len(s)
+		if i, ok := searching.LinearFunc(s, func(v int) bool { return v > target }); ok {
+			upper = i
+		}
+		first, last, found := -1, -1, lower < upper
+		if found {
+			first, last = lower, upper-1
+		}
+
+		if i, ok := searching.Linear(s, target); i != first || ok != found {
+			t.Fatalf("Linear(%d) = %d, %t, want %d, %t", target, i, ok, first, found)
+		}
+
+		var c counter
+		if i := searching.LowerBoundFunc(s, target, c.compare); i != lower || c.calls > bits.Len(uint(n)) {
+			t.Fatalf("LowerBound(%d) = %d after %d comparisons, want %d", target, i, c.calls, lower)
+		}
+
+		c = counter{}
+		if i := searching.UpperBoundFunc(s, target, c.compare); i != upper || c.calls > bits.Len(uint(n)) {
+			t.Fatalf("UpperBound(%d) = %d after %d comparisons, want %d", target, i, c.calls, upper)
+		}
+
+		c = counter{}
+		if i, ok := searching.FirstFunc(s, target, c.compare); i != first || ok != found || c.calls > bits.Len(uint(n))+1 {
+			t.Fatalf("First(%d) = %d, %t after %d comparisons, want %d, %t", target, i, ok, c.calls, first, found)
+		}
+
+		c = counter{}
+		if i, ok := searching.LastFunc(s, target, c.compare); i != last || ok != found || c.calls > bits.Len(uint(n))+1 {
+			t.Fatalf("Last(%d) = %d, %t after %d comparisons, want %d, %t", target, i, ok, c.calls, last, found)
+		}
+
+		c = counter{}
+		if i, ok := searching.ExponentialFunc(s, target, c.compare); i != first || ok != found || c.calls > 2*bits.Len(uint(lower))+4 {
+			t.Fatalf("Exponential(%d) = %d, %t after %d comparisons, want %d, %t", target, i, ok, c.calls, first, found)
+		}
+
+		c = counter{}
+		if i, ok := searching.TernaryFunc(s, target, c.compare); i != first || ok != found || c.calls > ternaryBound(n) {
+			t.Fatalf("Ternary(%d) = %d, %t after %d comparisons, want %d, %t", target, i, ok, c.calls, first, found)
+		}
+
+		c = counter{}
+		i, ok := searching.InterpolationFunc(s, target, c.key)
+		if ok != found || (found && s[i] != target) || c.calls > 3*(n+1) {
+			t.Fatalf("Interpolation(%d) = %d, %t after %d key calls, want an index of a match: %t", target, i, ok, c.calls, found)
+		}
+	}
+}
+
+// ternaryBound is the most comparisons TernaryFunc makes on n values: two per step plus the final check
+func ternaryBound(n int) int {
+	bound := 1
+	for m := n; m > 0; m = m - m/3 - 1 {
+		bound += 2
+	}
+	return bound
+}
+
+/*
+checkSelect selects every k of s with QuickSelect and KthSmallest and fails t if the value differs from sorting s,
+if s is not partitioned around k afterwards, or if a selection makes more comparisons than its bound
+*/
+func checkSelect(t testing.TB, s []int) {
+	t.Helper()
+
+	n := len(s)
+	sorted := slices.Clone(s)
+	slices.Sort(sorted)
+
+	selections := []struct {
+		name  string
+		bound int
+		kth   func(s []int, k int, compare func(a, b int) int) (int, error)
+	}{
+		// QuickSelect is O(n) on average only, the bound leaves room for unlucky pivots on small inputs
+		{"QuickSelect", 10*n + 10, searching.QuickSelectFunc[[]int]},
+		{"KthSmallest", 30 * n, searching.KthSmallestFunc[[]int]},
+	}
+
+	for _, selection := range selections {
+		for _, k := range []int{-1, n} {
+			if _, err := selection.kth(slices.Clone(s), k, cmp.Compare[int]); err != searching.ErrOutOfRange {
+				t.Fatalf("%s(k = %d) on %d values: got error %v, want %v", selection.name, k, n, err, searching.ErrOutOfRange)
+			}
+		}
+
+		for k := 0; k < n; k += max(1, n/16) {
+			got := slices.Clone(s)
+			var c counter
+			v, err := selection.kth(got, k, c.compare)
+			if err != nil || v != sorted[k] {
+				t.Fatalf("%s(k = %d) of %v = %d, %v, want %d", selection.name, k, s, v, err, sorted[k])
+			}
+			if slices.Max(append(got[:k:k], v)) != v || slices.Min(got[k:]) != v {
+				t.Fatalf("%s(k = %d) of %v left %v, not partitioned around %d", selection.name, k, s, got, v)
+			}
+			if c.calls > selection.bound {
+				t.Fatalf("%s(k = %d) on %d values: %d comparisons, more than %d", selection.name, k, n, c.calls, selection.bound)
+			}
+		}
+	}
+}
+
+// checkTernaryMax finds the peak of f(i) = -|i - peak| for every peak in [0, n) and fails t on a wrong index or too many calls
+func checkTernaryMax(t testing.TB, n int) {
+	t.Helper()
+
+	bound := 2
+	for m := n; m >= 3; m -= m / 3 {
+		bound += 2
+	}
+
+	for peak := 0; peak < n; peak++ {
+		calls := 0
+		f := func(i int) int {
+			calls++
+			return -max(i-peak, peak-i)
+		}
+
+		if i := searching.TernaryMax(n, f); i != peak || calls > bound {
+			t.Fatalf("TernaryMax(%d) with peak %d = %d after %d calls, want %d with at most %d", n, peak, i, calls, peak, bound)
+		}
+	}
+
+	if i := searching.TernaryMax(0, func(int) int { return 0 }); i != -1 {
+		t.Fatalf("TernaryMax(0) = %d, want -1", i)
+	}
+}
+
+// TestInterpolationFloats searches float keys whose interpolated position is not a number, which used to index out of range
+func TestInterpolationFloats(t *testing.T) {
+	inf := math.Inf(1)
+	s := []float64{-inf, -1, 0, 0.5, 2, inf}
+
+	for i, target := range s {
+		if got, ok := searching.Interpolation(s, target); got != i || !ok {
+			t.Fatalf("Interpolation(%v, %v) = %d, %t, want %d, true", s, target, got, ok, i)
+		}
+	}
+	for _, target := range []float64{math.NaN(), -0.5, 1, 3} {
+		if got, ok := searching.Interpolation(s, target); ok {
+			t.Fatalf("Interpolation(%v, %v) = %d, true, want not found", s, target, got)
+		}
+	}
+
+	nan := []float64{math.NaN(), math.NaN(), 1, 2, 3}
+	if got, ok := searching.Interpolation(nan, 2); got != 3 || !ok {
+		t.Fatalf("Interpolation(%v, 2) = %d, %t, want 3, true", nan, got, ok)
+	}
+}
+
+const (
+	// searchSize is the length of the sorted inputs the searches are benchmarked on
+	searchSize = 100000
+	// selectSize is the input length of the selections, small enough for the sort based selection to finish quickly
+	selectSize = 5000
+)
+
+// BenchmarkSearch runs every search on every one of the inputs, against LinkedList.FindIndexByValue as the baseline
+func BenchmarkSearch(b *testing.B) {
+	random := rand.New(rand.NewPCG(3, 4))
+	for _, input := range inputs {
+		s := input.Generate(random, searchSize)
+		list := linkedlist.New[int]()
+		for _, v := range s {
+			list.PushBack(v)
+		}
+		targets := make([]int, 64)
+		for i := range targets {
+			targets[i] = s[random.IntN(searchSize)]
+		}
+
+		searches := []struct {
+			name   string
+			search func(target int)
+		}{
+			{"LinkedList.FindIndex", func(target int) { list.FindIndexByValue(target) }},
+			{"Linear", func(target int) { searching.Linear(s, target) }},
+			{"Binary", func(target int) { searching.First(s, target) }},
+			{"Exponential", func(target int) { searching.Exponential(s, target) }},
+			{"Interpolation", func(target int) { searching.Interpolation(s, target) }},
+			{"Ternary", func(target int) { searching.Ternary(s, target) }},
+		}
+		for _, search := range searches {
+			b.Run(fmt.Sprintf("%s/%s", search.name, input.Name), func(b *testing.B) {
+				for i := range b.N {
+					search.search(targets[i%len(targets)])
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkSelect finds the median of every shape of sorttest, against sorting the whole slice
+func BenchmarkSelect(b *testing.B) {
+	selections := []struct {
+		name string
+		kth  func(s []int, k int) (int, error)
+	}{
+		{"QuickSelect", searching.QuickSelect[[]int]},
+		{"KthSmallest", searching.KthSmallest[[]int]},
+		{"SortThenIndex", func(s []int, k int) (int, error) {
+			sorting.Intro(s)
+			return s[k], nil
+		}},
+	}
+
+	random := rand.New(rand.NewPCG(3, 4))
+	for _, shape := range sorttest.Shapes {
+		input := shape.Generate(random, selectSize)
+		for _, selection := range selections {
+			b.Run(fmt.Sprintf("%s/%s", selection.name, shape.Name), func(b *testing.B) {
+				s := make([]int, len(input))
+				for range b.N {
+					copy(s, input)
+					selection.kth(s, len(s)/2)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package searching
+
+import (
+	"cmp"
+	"math/rand/v2"
+
+	"algorithms/sorting"
+)
+
+// QuickSelect returns the k-th smallest value of s (k counts from 0), see QuickSelectFunc
+func QuickSelect[S ~[]E, E cmp.Ordered](s S, k int) (E, error) {
+	return QuickSelectFunc(s, k, cmp.Compare[E])
+}
+
+/*
+QuickSelectFunc returns the k-th smallest value of s (k counts from 0) and reorders s around it:
+afterwards s[k] holds the value, nothing before it is larger and nothing after it is smaller
+It is quicksort that only recurses into the side holding k, so it takes O(n) comparisons on average
+The pivot is picked at random, so no fixed input is slow, and values equal to the pivot are split off
+in a three way partition, so many duplicates do not make it quadratic either. The worst case is still O(n^2)
+If k is not an index of s, it returns ErrOutOfRange
+*/
+func QuickSelectFunc[S ~[]E, E any](s S, k int, compare func(a, b E) int) (E, error) {
+	if k < 0 || k >= len(s) {
+		var zero E
+		return zero, ErrOutOfRange
+	}
+
+	lo, hi := 0, len(s)
+	for hi-lo > 1 {
+		pivot := s[lo+rand.IntN(hi-lo)]
+		lt, gt := partition(s, lo, hi, pivot, compare)
+		switch {
+		case k < lt:
+			hi = lt
+		case k >= gt:
+			lo = gt
+		default:
+			return s[k], nil
+		}
+	}
+	return s[k], nil
+}
+
+// KthSmallest returns the k-th smallest value of s (k counts from 0), see KthSmallestFunc
+func KthSmallest[S ~[]E, E cmp.Ordered](s S, k int) (E, error) {
+	return KthSmallestFunc(s, k, cmp.Compare[E])
+}
+
+/*
+KthSmallestFunc returns the k-th smallest value of s (k counts from 0) and reorders s like QuickSelectFunc
+It picks the pivot with the median of medians: the median of the medians of groups of 5 values
+At least 3/10 of the values are smaller and 3/10 larger than that pivot, so every round drops 30% of the range
+and it takes O(n) comparisons in the worst case, whatever the input. The constant is a few times that of QuickSelect
+If k is not an index of s, it returns ErrOutOfRange
+*/
+func KthSmallestFunc[S ~[]E, E any](s S, k int, compare func(a, b E) int) (E, error) {
+	if k < 0 || k >= len(s) {
+		var zero E
+		return zero, ErrOutOfRange
+	}
+
+	selectRange(s, 0, len(s), k, compare)
+	return s[k], nil
+}
+
+// selectRange moves the k-th smallest value of s[lo:hi] to s[k] with median of medians pivots
+func selectRange[S ~[]E, E any](s S, lo, hi, k int, compare func(a, b E) int) {
+	for hi-lo > 5 {
+		pivot := medianOfMedians(s, lo, hi, compare)
+		lt, gt := partition(s, lo, hi, pivot, compare)
+		switch {
+		case k < lt:
+			hi = lt
+		case k >= gt:
+			lo = gt
+		default:
+			return
+		}
+	}
+	sorting.InsertionFunc(s[lo:hi], compare)
+}
+
+/*
+medianOfMedians returns the median of the medians of the groups of 5 values in s[lo:hi]
+Every group is sorted and its median is moved to the front of the range, then selectRange finds the median of those
+*/
+func medianOfMedians[S ~[]E, E any](s S, lo, hi int, compare func(a, b E) int) E {
+	medians := lo
+	for group := lo; group < hi; group += 5 {
+		end := min(group+5, hi)
+		sorting.InsertionFunc(s[group:end], compare)
+		middle := group + (end-group)/2
+		s[medians], s[middle] = s[middle], s[medians]
+		medians++
+	}
+
+	middle := lo + (medians-lo)/2
+	selectRange(s, lo, medians, middle, compare)
+	return s[middle]
+}
+
+/*
+partition reorders s[lo:hi] into the values smaller than pivot, the values equal to it and the values larger than it
+and returns lt and gt, the bounds of the equal part
+*/
+func partition[S ~[]E, E any](s S, lo, hi int, pivot E, compare func(a, b E) int) (lt, gt int) {
+	lt, gt = lo, hi
+	for i := lo; i < gt; {
+		switch c := compare(s[i], pivot); {
+		case c < 0:
+			s[lt], s[i] = s[i], s[lt]
+			lt++
+			i++
+		case c > 0:
+			gt--
+			s[gt], s[i] = s[i], s[gt]
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
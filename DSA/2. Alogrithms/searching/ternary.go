@@ -0,0 +1,67 @@
+package searching
+
+import "cmp"
+
+// Ternary returns the index of the first occurrence of target in the sorted slice s, see TernaryFunc
+func Ternary[S ~[]E, E cmp.Ordered](s S, target E) (int, bool) {
+	return TernaryFunc(s, target, cmp.Compare[E])
+}
+
+/*
+TernaryFunc returns the index of the first value equal to target, or -1 and false
+It splits the range in three with two probes per step, so it takes log3 n steps but up to 2 log3 n = 1.26 log2 n
+comparisons, more than binary search: it is here to show why splitting in two is the better choice on sorted data
+*/
+func TernaryFunc[S ~[]E, E, T any](s S, target T, compare func(v E, target T) int) (int, bool) {
+	lo, hi := 0, len(s)
+	for lo < hi {
+		third := (hi - lo) / 3
+		first, second := lo+third, lo+2*third
+		switch {
+		case compare(s[first], target) >= 0:
+			hi = first
+		case compare(s[second], target) >= 0:
+			lo, hi = first+1, second
+		default:
+			lo = second + 1
+		}
+	}
+
+	if lo < len(s) && compare(s[lo], target) == 0 {
+		return lo, true
+	}
+	return -1, false
+}
+
+// TernaryMax returns the index in [0, n) where f is largest, see TernaryMaxFunc
+func TernaryMax[E cmp.Ordered](n int, f func(i int) E) int {
+	return TernaryMaxFunc(n, f, cmp.Compare[E])
+}
+
+/*
+TernaryMaxFunc returns the index in [0, n) where f is largest, or -1 when n is 0
+f must be unimodal: strictly increasing up to its maximum and strictly decreasing after it
+This is where ternary search earns its place, binary search needs a sorted range but a peak is not one
+Comparing f at two inner points tells which outer third cannot hold the peak, so it takes about 2 log_1.5 n calls
+*/
+func TernaryMaxFunc[E any](n int, f func(i int) E, compare func(a, b E) int) int {
+	lo, hi := 0, n
+	for hi-lo >= 3 {
+		third := (hi - lo) / 3
+		first, second := lo+third, lo+2*third
+		if compare(f(first), f(second)) < 0 {
+			lo = first + 1
+		} else {
+			hi = second
+		}
+	}
+
+	best := -1
+	var bestValue E
+	for i := lo; i < hi; i++ {
+		if v := f(i); best == -1 || compare(v, bestValue) > 0 {
+			best, bestValue = i, v
+		}
+	}
+	return best
+}
@@ -0,0 +1,185 @@
+package sorting
+
+import "slices"
+
+/*
+countingRange is the number of counters counting sort always allows itself
+For a wider key range it allows up to two counters per value, beyond that it falls back to radix sort,
+which sorts the same keys in O(8 n) without memory per possible key
+*/
+const countingRange = 1 << 16
+
+// Counting sorts s in ascending order with counting sort, see CountingFunc
+func Counting[S ~[]E, E Integer](s S) {
+	counting(s, integerKey[E])
+}
+
+/*
+CountingFunc sorts s by an integer key with counting sort
+It counts how often every key between the smallest and the largest occurs, turns the counts into start
+positions and copies every value to its position, in order, so it is stable
+It runs in O(n + k) time and memory where k is the range of the keys, it only pays off when k is not much larger than n
+When k is larger than both countingRange and 2 n it sorts with RadixFunc instead, which is stable as well
+*/
+func CountingFunc[S ~[]E, E any](s S, key func(v E) int) {
+	counting(s, func(v E) uint64 { return uint64(key(v)) ^ (1 << 63) })
+}
+
+/*
+counting sorts s by a key that maps the values to uint64 in order
+The range is computed on the unsigned keys, where the difference between the largest and the smallest key never overflows
+*/
+func counting[S ~[]E, E any](s S, key func(v E) uint64) {
+	if len(s) < 2 {
+		return
+	}
+
+	keys := make([]uint64, len(s))
+	for i, v := range s {
+		keys[i] = key(v)
+	}
+	smallest, largest := slices.Min(keys), slices.Max(keys)
+
+	if span := largest - smallest; span >= max(countingRange, 2*uint64(len(s))) {
+		RadixFunc(s, key)
+		return
+	}
+
+	positions := make([]int, largest-smallest+1)
+	for _, k := range keys {
+		positions[k-smallest]++
+	}
+	start := 0
+	for i, count := range positions {
+		positions[i] = start
+		start += count
+	}
+
+	sorted := make([]E, len(s))
+	for i, v := range s {
+		k := keys[i] - smallest
+		sorted[positions[k]] = v
+		positions[k]++
+	}
+	copy(s, sorted)
+}
+
+// Radix sorts s in ascending order with LSD radix sort, see RadixFunc
+func Radix[S ~[]E, E Integer](s S) {
+	RadixFunc(s, integerKey[E])
+}
+
+// integerKey maps an integer to a uint64 in the same order, flipping the sign bit orders negative numbers before positive ones
+func integerKey[E Integer](v E) uint64 {
+	if ^E(0) < 0 {
+		return uint64(int64(v)) ^ (1 << 63)
+	}
+	return uint64(v)
+}
+
+/*
+RadixFunc sorts s by an unsigned 64 bit key with least significant digit radix sort
+It makes one stable counting sort pass per byte of the key, from the lowest byte to the highest,
+so after the last pass the values are ordered by the whole key. Passes where every key has the same byte are skipped
+It is stable and runs in O(8 n) time with O(n) extra memory
+*/
+func RadixFunc[S ~[]E, E any](s S, key func(v E) uint64) {
+	if len(s) < 2 {
+		return
+	}
+
+	keys := make([]uint64, len(s))
+	for i, v := range s {
+		keys[i] = key(v)
+	}
+
+	src, dst := []E(s), make([]E, len(s))
+	srcKeys, dstKeys := keys, make([]uint64, len(s))
+	for shift := 0; shift < 64; shift += 8 {
+		var positions [256]int
+		for _, k := range srcKeys {
+			positions[byte(k>>shift)]++
+		}
+		if positions[byte(srcKeys[0]>>shift)] == len(s) {
+			continue
+		}
+
+		start := 0
+		for b, count := range positions {
+			positions[b] = start
+			start += count
+		}
+
+		for i, k := range srcKeys {
+			b := byte(k >> shift)
+			dst[positions[b]] = src[i]
+			dstKeys[positions[b]] = k
+			positions[b]++
+		}
+		src, dst = dst, src
+		srcKeys, dstKeys = dstKeys, srcKeys
+	}
+
+	if &src[0] != &s[0] {
+		copy(s, src)
+	}
+}
+
+// RadixStrings sorts s in ascending byte order with LSD radix sort, see RadixStringsFunc
+func RadixStrings[S ~[]string](s S) {
+	RadixStringsFunc(s, func(v string) string { return v })
+}
+
+/*
+RadixStringsFunc sorts s by a string key with least significant digit radix sort
+It makes one stable counting sort pass per byte position, from the last position of the longest key to the first
+A key that is too short for a position sorts before every byte there, which puts "ab" before "abc" like the < operator
+It is stable and runs in O(w n) time where w is the length of the longest key, with O(n) extra memory
+*/
+func RadixStringsFunc[S ~[]E, E any](s S, key func(v E) string) {
+	if len(s) < 2 {
+		return
+	}
+
+	keys := make([]string, len(s))
+	width := 0
+	for i, v := range s {
+		keys[i] = key(v)
+		width = max(width, len(keys[i]))
+	}
+
+	src, dst := []E(s), make([]E, len(s))
+	srcKeys, dstKeys := keys, make([]string, len(s))
+	for position := width - 1; position >= 0; position-- {
+		// Bucket 0 is for keys shorter than position + 1, byte b goes to bucket b + 1
+		var positions [257]int
+		bucket := func(k string) int {
+			if position < len(k) {
+				return int(k[position]) + 1
+			}
+			return 0
+		}
+
+		for _, k := range srcKeys {
+			positions[bucket(k)]++
+		}
+		start := 0
+		for b, count := range positions {
+			positions[b] = start
+			start += count
+		}
+
+		for i, k := range srcKeys {
+			b := bucket(k)
+			dst[positions[b]] = src[i]
+			dstKeys[positions[b]] = k
+			positions[b]++
+		}
+		src, dst = dst, src
+		srcKeys, dstKeys = dstKeys, srcKeys
+	}
+
+	if &src[0] != &s[0] {
+		copy(s, src)
+	}
+}
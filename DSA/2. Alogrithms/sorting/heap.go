@@ -0,0 +1,49 @@
+package sorting
+
+import "cmp"
+
+// Heap sorts s in ascending order with heapsort, see HeapFunc
+func Heap[S ~[]E, E cmp.Ordered](s S) {
+	HeapFunc(s, cmp.Compare[E])
+}
+
+/*
+HeapFunc sorts s with heapsort
+It turns s into a max-heap in O(n), then repeatedly swaps the largest value to the end and restores the heap
+on the shrinking prefix. It is not stable, runs in O(n log n) time in every case and needs no extra memory
+*/
+func HeapFunc[S ~[]E, E any](s S, compare func(a, b E) int) {
+	heapSortRange(s, 0, len(s), compare)
+}
+
+// heapSortRange sorts s[lo:hi] with heapsort, introsort uses it on the ranges where quicksort goes badly
+func heapSortRange[S ~[]E, E any](s S, lo, hi int, compare func(a, b E) int) {
+	heap := s[lo:hi]
+	for i := len(heap)/2 - 1; i >= 0; i-- {
+		siftDown(heap, i, len(heap), compare)
+	}
+
+	for end := len(heap) - 1; end > 0; end-- {
+		heap[0], heap[end] = heap[end], heap[0]
+		siftDown(heap, 0, end, compare)
+	}
+}
+
+// siftDown moves heap[root] down until both children are not larger, only heap[:n] is part of the heap
+func siftDown[S ~[]E, E any](heap S, root, n int, compare func(a, b E) int) {
+	for {
+		child := 2*root + 1
+		if child >= n {
+			return
+		}
+		if child+1 < n && compare(heap[child+1], heap[child]) > 0 {
+			child++
+		}
+		if compare(heap[child], heap[root]) <= 0 {
+			return
+		}
+
+		heap[root], heap[child] = heap[child], heap[root]
+		root = child
+	}
+}
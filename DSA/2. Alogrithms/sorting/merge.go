@@ -0,0 +1,98 @@
+package sorting
+
+import "cmp"
+
+// insertionCutoff is the length below which the merge and quick sorts finish a range with insertion sort
+const insertionCutoff = 12
+
+// MergeTopDown sorts s in ascending order with top-down merge sort, see MergeTopDownFunc
+func MergeTopDown[S ~[]E, E cmp.Ordered](s S) {
+	MergeTopDownFunc(s, cmp.Compare[E])
+}
+
+/*
+MergeTopDownFunc sorts s with recursive merge sort
+It sorts both halves and merges them, short ranges are sorted with insertion sort
+One buffer as long as s is allocated up front and shared by every merge
+It is stable and runs in O(n log n) time with O(n) extra memory
+*/
+func MergeTopDownFunc[S ~[]E, E any](s S, compare func(a, b E) int) {
+	buffer := make([]E, len(s))
+	mergeSort(s, buffer, compare)
+}
+
+// mergeSort sorts s using buffer, which must be at least as long as s
+func mergeSort[S ~[]E, E any](s S, buffer []E, compare func(a, b E) int) {
+	if len(s) <= insertionCutoff {
+		insertionRange(s, 0, len(s), compare)
+		return
+	}
+
+	middle := len(s) / 2
+	mergeSort(s[:middle], buffer, compare)
+	mergeSort(s[middle:], buffer, compare)
+	if compare(s[middle], s[middle-1]) >= 0 {
+		return
+	}
+
+	copy(buffer, s[:middle])
+	merge(s, buffer[:middle], s[middle:], compare)
+}
+
+/*
+merge merges the sorted slices left and right into dst, which must be len(left) + len(right) long
+right may be the tail of dst itself: the write position never passes the next unread value of right
+On ties the value from left comes first, which keeps the sort stable
+*/
+func merge[E any](dst, left, right []E, compare func(a, b E) int) {
+	i, j, k := 0, 0, 0
+	for i < len(left) && j < len(right) {
+		if compare(right[j], left[i]) < 0 {
+			dst[k] = right[j]
+			j++
+		} else {
+			dst[k] = left[i]
+			i++
+		}
+		k++
+	}
+
+	k += copy(dst[k:], left[i:])
+	copy(dst[k:], right[j:])
+}
+
+// MergeBottomUp sorts s in ascending order with bottom-up merge sort, see MergeBottomUpFunc
+func MergeBottomUp[S ~[]E, E cmp.Ordered](s S) {
+	MergeBottomUpFunc(s, cmp.Compare[E])
+}
+
+/*
+MergeBottomUpFunc sorts s with iterative merge sort
+It first sorts blocks of insertionCutoff values with insertion sort, then merges neighbouring runs of width
+w, 2w, 4w ... until one run is left, so it needs no recursion
+Each pass merges from one slice into the other, the two swap roles every pass and the result is copied back at the end
+It is stable and runs in O(n log n) time with O(n) extra memory
+*/
+func MergeBottomUpFunc[S ~[]E, E any](s S, compare func(a, b E) int) {
+	n := len(s)
+	for lo := 0; lo < n; lo += insertionCutoff {
+		insertionRange(s, lo, min(lo+insertionCutoff, n), compare)
+	}
+	if n <= insertionCutoff {
+		return
+	}
+
+	src, dst := []E(s), make([]E, n)
+	for width := insertionCutoff; width < n; width *= 2 {
+		for lo := 0; lo < n; lo += 2 * width {
+			middle := min(lo+width, n)
+			hi := min(lo+2*width, n)
+			merge(dst[lo:hi], src[lo:middle], src[middle:hi], compare)
+		}
+		src, dst = dst, src
+	}
+
+	if &src[0] != &s[0] {
+		copy(s, src)
+	}
+}
@@ -0,0 +1,199 @@
+package sorting
+
+import (
+	"cmp"
+	"math/bits"
+)
+
+// medianOfThree orders s[lo], s[middle] and s[hi] and returns middle, the index of their median
+func medianOfThree[S ~[]E, E any](s S, lo, hi int, compare func(a, b E) int) int {
+	middle := lo + (hi-lo)/2
+	if compare(s[middle], s[lo]) < 0 {
+		s[middle], s[lo] = s[lo], s[middle]
+	}
+	if compare(s[hi], s[middle]) < 0 {
+		s[hi], s[middle] = s[middle], s[hi]
+		if compare(s[middle], s[lo]) < 0 {
+			s[middle], s[lo] = s[lo], s[middle]
+		}
+	}
+	return middle
+}
+
+// QuickLomuto sorts s in ascending order with quicksort and Lomuto partitioning, see QuickLomutoFunc
+func QuickLomuto[S ~[]E, E cmp.Ordered](s S) {
+	QuickLomutoFunc(s, cmp.Compare[E])
+}
+
+/*
+QuickLomutoFunc sorts s with quicksort using the Lomuto partition scheme
+The pivot (the median of the first, middle and last value) is moved to the end, one scan moves every smaller
+value in front of a growing boundary and the pivot is swapped onto the boundary
+It recurses into the smaller side and loops on the larger one, so the stack stays O(log n)
+It is not stable and degrades to O(n^2) when many values are equal, because they all land on one side
+*/
+func QuickLomutoFunc[S ~[]E, E any](s S, compare func(a, b E) int) {
+	lo, hi := 0, len(s)-1
+	for hi-lo >= insertionCutoff {
+		pivot := lomutoPartition(s, lo, hi, compare)
+		if pivot-lo < hi-pivot {
+			QuickLomutoFunc(s[lo:pivot], compare)
+			lo = pivot + 1
+		} else {
+			QuickLomutoFunc(s[pivot+1:hi+1], compare)
+			hi = pivot - 1
+		}
+	}
+	insertionRange(s, lo, hi+1, compare)
+}
+
+// lomutoPartition partitions s[lo:hi+1] around a median of three pivot and returns the pivot's final index
+func lomutoPartition[S ~[]E, E any](s S, lo, hi int, compare func(a, b E) int) int {
+	middle := medianOfThree(s, lo, hi, compare)
+	s[middle], s[hi] = s[hi], s[middle]
+
+	boundary := lo
+	for i := lo; i < hi; i++ {
+		if compare(s[i], s[hi]) < 0 {
+			s[i], s[boundary] = s[boundary], s[i]
+			boundary++
+		}
+	}
+	s[boundary], s[hi] = s[hi], s[boundary]
+	return boundary
+}
+
+// QuickHoare sorts s in ascending order with quicksort and Hoare partitioning, see QuickHoareFunc
+func QuickHoare[S ~[]E, E cmp.Ordered](s S) {
+	QuickHoareFunc(s, cmp.Compare[E])
+}
+
+/*
+QuickHoareFunc sorts s with quicksort using the Hoare partition scheme
+Two indices move towards each other from both ends and swap the pairs that are on the wrong side of the pivot
+It does about three times fewer swaps than Lomuto and values equal to the pivot are split evenly between both
+sides, so many equal values do not hurt it
+It is not stable and its worst case is O(n^2), the median of three pivot makes that unlikely
+*/
+func QuickHoareFunc[S ~[]E, E any](s S, compare func(a, b E) int) {
+	lo, hi := 0, len(s)-1
+	for hi-lo >= insertionCutoff {
+		split := hoarePartition(s, lo, hi, compare)
+		if split-lo < hi-split {
+			QuickHoareFunc(s[lo:split+1], compare)
+			lo = split + 1
+		} else {
+			QuickHoareFunc(s[split+1:hi+1], compare)
+			hi = split
+		}
+	}
+	insertionRange(s, lo, hi+1, compare)
+}
+
+/*
+hoarePartition partitions s[lo:hi+1] and returns split: every value in s[lo:split+1] is not larger
+than every value in s[split+1:hi+1]
+The pivot is the median of three, which also leaves sentinels at both ends so the scans cannot run off the range
+*/
+func hoarePartition[S ~[]E, E any](s S, lo, hi int, compare func(a, b E) int) int {
+	pivot := s[medianOfThree(s, lo, hi, compare)]
+	i, j := lo-1, hi+1
+	for {
+		for i++; compare(s[i], pivot) < 0; i++ {
+		}
+		for j--; compare(s[j], pivot) > 0; j-- {
+		}
+		if i >= j {
+			return j
+		}
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Quick3Way sorts s in ascending order with three-way quicksort, see Quick3WayFunc
+func Quick3Way[S ~[]E, E cmp.Ordered](s S) {
+	Quick3WayFunc(s, cmp.Compare[E])
+}
+
+/*
+Quick3WayFunc sorts s with Dijkstra's three-way partitioning quicksort
+Each partition splits the range into values smaller than, equal to and larger than the pivot,
+and only the smaller and larger parts are sorted further, so input with few distinct values sorts in about O(n)
+It is not stable and its worst case is O(n^2), the median of three pivot makes that unlikely
+*/
+func Quick3WayFunc[S ~[]E, E any](s S, compare func(a, b E) int) {
+	lo, hi := 0, len(s)-1
+	for hi-lo >= insertionCutoff {
+		lt, gt := threeWayPartition(s, lo, hi, compare)
+		if lt-lo < hi-gt {
+			Quick3WayFunc(s[lo:lt], compare)
+			lo = gt + 1
+		} else {
+			Quick3WayFunc(s[gt+1:hi+1], compare)
+			hi = lt - 1
+		}
+	}
+	insertionRange(s, lo, hi+1, compare)
+}
+
+/*
+threeWayPartition partitions s[lo:hi+1] around a median of three pivot
+Afterwards s[lo:lt] is smaller than the pivot, s[lt:gt+1] is equal to it and s[gt+1:hi+1] is larger
+*/
+func threeWayPartition[S ~[]E, E any](s S, lo, hi int, compare func(a, b E) int) (lt, gt int) {
+	pivot := s[medianOfThree(s, lo, hi, compare)]
+	lt, gt = lo, hi
+	for i := lo; i <= gt; {
+		switch c := compare(s[i], pivot); {
+		case c < 0:
+			s[lt], s[i] = s[i], s[lt]
+			lt++
+			i++
+		case c > 0:
+			s[i], s[gt] = s[gt], s[i]
+			gt--
+		default:
+			i++
+		}
+	}
+	return lt, gt
+}
+
+// Intro sorts s in ascending order with introsort, see IntroFunc
+func Intro[S ~[]E, E cmp.Ordered](s S) {
+	IntroFunc(s, cmp.Compare[E])
+}
+
+/*
+IntroFunc sorts s with introsort: quicksort with Hoare partitioning that watches its own recursion depth
+When the depth passes 2 log2(n) the partitions are going badly, and the range is finished with heapsort instead,
+which caps the worst case at O(n log n). Short ranges are finished with insertion sort
+It is not stable, this is the scheme most standard library sorts are built on
+*/
+func IntroFunc[S ~[]E, E any](s S, compare func(a, b E) int) {
+	if len(s) < 2 {
+		return
+	}
+	introSort(s, 0, len(s)-1, 2*bits.Len(uint(len(s))), compare)
+}
+
+// introSort sorts s[lo:hi+1] and switches to heapsort when depth reaches 0
+func introSort[S ~[]E, E any](s S, lo, hi, depth int, compare func(a, b E) int) {
+	for hi-lo >= insertionCutoff {
+		if depth == 0 {
+			heapSortRange(s, lo, hi+1, compare)
+			return
+		}
+		depth--
+
+		split := hoarePartition(s, lo, hi, compare)
+		if split-lo < hi-split {
+			introSort(s, lo, split, depth, compare)
+			lo = split + 1
+		} else {
+			introSort(s, split+1, hi, depth, compare)
+			hi = split
+		}
+	}
+	insertionRange(s, lo, hi+1, compare)
+}
@@ -0,0 +1,107 @@
+package sorting
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Insertion sorts s in ascending order with insertion sort, see InsertionFunc
+func Insertion[S ~[]E, E cmp.Ordered](s S) {
+	InsertionFunc(s, cmp.Compare[E])
+}
+
+/*
+InsertionFunc sorts s with insertion sort
+Every value is moved left past the larger values before it, so the prefix s[:i] is always sorted
+It is stable, runs in O(n^2) time, and in O(n) on input that is already (nearly) sorted,
+which is why the faster sorts use it for short ranges
+*/
+func InsertionFunc[S ~[]E, E any](s S, compare func(a, b E) int) {
+	insertionRange(s, 0, len(s), compare)
+}
+
+// insertionRange sorts s[lo:hi] with insertion sort
+func insertionRange[S ~[]E, E any](s S, lo, hi int, compare func(a, b E) int) {
+	for i := lo + 1; i < hi; i++ {
+		for j := i; j > lo && compare(s[j], s[j-1]) < 0; j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
+
+// Selection sorts s in ascending order with selection sort, see SelectionFunc
+func Selection[S ~[]E, E cmp.Ordered](s S) {
+	SelectionFunc(s, cmp.Compare[E])
+}
+
+/*
+SelectionFunc sorts s with selection sort
+It finds the smallest value of the unsorted suffix and swaps it to the front of that suffix
+It makes at most n swaps but always O(n^2) comparisons, and the long distance swaps make it unstable
+*/
+func SelectionFunc[S ~[]E, E any](s S, compare func(a, b E) int) {
+	for i := 0; i < len(s)-1; i++ {
+		smallest := i
+		for j := i + 1; j < len(s); j++ {
+			if compare(s[j], s[smallest]) < 0 {
+				smallest = j
+			}
+		}
+		s[i], s[smallest] = s[smallest], s[i]
+	}
+}
+
+// Bubble sorts s in ascending order with bubble sort, see BubbleFunc
+func Bubble[S ~[]E, E cmp.Ordered](s S) {
+	BubbleFunc(s, cmp.Compare[E])
+}
+
+/*
+BubbleFunc sorts s with bubble sort
+Every pass swaps neighbours that are out of order, which carries the largest value of the unsorted prefix to its end
+Nothing after the last swap of a pass can move again, so the next pass stops there and a pass without swaps ends the sort
+It is stable and runs in O(n^2) time, O(n) on sorted input
+*/
+func BubbleFunc[S ~[]E, E any](s S, compare func(a, b E) int) {
+	for end := len(s); end > 1; {
+		lastSwap := 0
+		for j := 1; j < end; j++ {
+			if compare(s[j], s[j-1]) < 0 {
+				s[j], s[j-1] = s[j-1], s[j]
+				lastSwap = j
+			}
+		}
+		end = lastSwap
+	}
+}
+
+// Shell sorts s in ascending order with Shell sort, see ShellFunc
+func Shell[S ~[]E, E cmp.Ordered](s S) {
+	ShellFunc(s, cmp.Compare[E])
+}
+
+// shellGaps is the Ciura gap sequence, larger gaps are made by multiplying the last one by 2.25
+var shellGaps = []int{1, 4, 10, 23, 57, 132, 301, 701, 1750}
+
+/*
+ShellFunc sorts s with Shell sort
+It runs insertion sort over the values that are gap apart, for shrinking gaps down to 1
+The large gaps move values close to their place in a few long jumps, so the final insertion sort has little left to do
+It uses the Ciura gaps, is not stable and has no proven bound, in practice it is close to O(n^(4/3))
+*/
+func ShellFunc[S ~[]E, E any](s S, compare func(a, b E) int) {
+	gaps := slices.Clone(shellGaps)
+	for last := gaps[len(gaps)-1]; last < len(s)/2; {
+		last = last * 9 / 4
+		gaps = append(gaps, last)
+	}
+
+	for g := len(gaps) - 1; g >= 0; g-- {
+		gap := gaps[g]
+		for i := gap; i < len(s); i++ {
+			for j := i; j >= gap && compare(s[j], s[j-gap]) < 0; j -= gap {
+				s[j], s[j-gap] = s[j-gap], s[j]
+			}
+		}
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Package sorting provides the classic sorting algorithms over slices
+Every comparison sort comes in two forms: Name sorts a slice of a cmp.Ordered type in ascending order
+and NameFunc sorts any slice with a compare function that returns a negative number when a < b,
+zero when a == b and a positive number when a > b
+The distribution sorts (Counting, Radix, RadixStrings) do not compare values, they sort by an integer or string key
+Algorithms lists every comparison sort with its stability, so callers and tests can pick one by its properties
+*/
+package sorting
+
+import "cmp"
+
+// Integer is the set of integer types the distribution sorts accept
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+/*
+Algorithm describes one sorting algorithm
+Stable reports whether equal values keep their original order, InPlace whether it needs only O(log n) extra memory
+Time is the worst case running time, Sort sorts a slice in place
+*/
+type Algorithm[E any] struct {
+	Name    string
+	Stable  bool
+	InPlace bool
+	Time    string
+	Sort    func(s []E)
+}
+
+// Algorithms returns every comparison sort for an ordered type, sorting in ascending order
+func Algorithms[E cmp.Ordered]() []Algorithm[E] {
+	return AlgorithmsFunc(cmp.Compare[E])
+}
+
+// AlgorithmsFunc returns every comparison sort, ordering the values with compare
+func AlgorithmsFunc[E any](compare func(a, b E) int) []Algorithm[E] {
+	return []Algorithm[E]{
+		{"Insertion", true, true, "O(n^2)", func(s []E) { InsertionFunc(s, compare) }},
+		{"Selection", false, true, "O(n^2)", func(s []E) { SelectionFunc(s, compare) }},
+		{"Bubble", true, true, "O(n^2)", func(s []E) { BubbleFunc(s, compare) }},
+		{"Shell", false, true, "O(n^(4/3))", func(s []E) { ShellFunc(s, compare) }},
+		{"MergeTopDown", true, false, "O(n log n)", func(s []E) { MergeTopDownFunc(s, compare) }},
+		{"MergeBottomUp", true, false, "O(n log n)", func(s []E) { MergeBottomUpFunc(s, compare) }},
+		{"QuickLomuto", false, true, "O(n^2)", func(s []E) { QuickLomutoFunc(s, compare) }},
+		{"QuickHoare", false, true, "O(n^2)", func(s []E) { QuickHoareFunc(s, compare) }},
+		{"Quick3Way", false, true, "O(n^2)", func(s []E) { Quick3WayFunc(s, compare) }},
+		{"Intro", false, true, "O(n log n)", func(s []E) { IntroFunc(s, compare) }},
+		{"Heap", false, true, "O(n log n)", func(s []E) { HeapFunc(s, compare) }},
+		{"Tim", true, false, "O(n log n)", func(s []E) { TimFunc(s, compare) }},
+	}
+}
+
+// IntAlgorithms returns every comparison sort for ints followed by the distribution sorts
+func IntAlgorithms() []Algorithm[int] {
+	return append(Algorithms[int](),
+		Algorithm[int]{"Counting", true, false, "O(n + k)", Counting[[]int]},
+		Algorithm[int]{"Radix", true, false, "O(w n)", Radix[[]int]},
+	)
+}
@@ -0,0 +1,65 @@
+package sorting_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"algorithms/sorting"
+	"algorithms/sorting/sorttest"
+)
+
+func TestSorting(t *testing.T) { sorttest.Run(t) }
+
+func FuzzSorting(f *testing.F) { sorttest.Fuzz(f) }
+
+// checkCounting sorts a copy of input with Counting and fails t if the result differs from slices.Sort
+func checkCounting[E sorting.Integer](t *testing.T, input []E) {
+	t.Helper()
+
+	want := slices.Clone(input)
+	slices.Sort(want)
+	got := slices.Clone(input)
+	sorting.Counting(got)
+	if !slices.Equal(got, want) {
+		t.Fatalf("Counting(%v): got %v, want %v", input, got, want)
+	}
+}
+
+// TestCountingWideRange sorts keys whose range does not fit an int, or is too wide to allocate a counter per key
+func TestCountingWideRange(t *testing.T) {
+	checkCounting(t, []uint64{1 << 63, 5, 0})
+	checkCounting(t, []uint64{math.MaxUint64, 0, math.MaxUint64 - 1, 1})
+	checkCounting(t, []int64{math.MaxInt64, math.MinInt64, 0, -1, math.MinInt64})
+	checkCounting(t, []int8{math.MaxInt8, math.MinInt8, 0, -1})
+	checkCounting(t, []uint8{math.MaxUint8, 0, 7, math.MaxUint8})
+	checkCounting(t, []int{1 << 40, -(1 << 40), 3, 3})
+
+	got := []int{math.MaxInt, math.MinInt, 0}
+	sorting.CountingFunc(got, func(v int) int { return v })
+	if want := []int{math.MinInt, 0, math.MaxInt}; !slices.Equal(got, want) {
+		t.Fatalf("CountingFunc: got %v, want %v", got, want)
+	}
+}
+
+// benchmarkSize is the input length of the benchmark matrix, small enough for the O(n^2) sorts to finish quickly
+const benchmarkSize = 5000
+
+// BenchmarkSort runs every algorithm on every shape of sorttest
+func BenchmarkSort(b *testing.B) {
+	random := rand.New(rand.NewPCG(1, 2))
+	for _, shape := range sorttest.Shapes {
+		input := shape.Generate(random, benchmarkSize)
+		for _, algorithm := range sorting.IntAlgorithms() {
+			b.Run(fmt.Sprintf("%s/%s", algorithm.Name, shape.Name), func(b *testing.B) {
+				s := make([]int, len(input))
+				for range b.N {
+					copy(s, input)
+					algorithm.Sort(s)
+				}
+			})
+		}
+	}
+}
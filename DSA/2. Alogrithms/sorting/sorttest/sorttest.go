@@ -0,0 +1,176 @@
+/*
+Package sorttest is a correctness suite for the sorting package
+It sorts generated inputs with every algorithm and checks the result against slices.Sort,
+and for every algorithm marked Stable it also checks that equal keys keep their original order
+The same inputs drive the benchmarks of the sorting package through Shapes
+
+Use it from a test file:
+
+	func TestSorting(t *testing.T) { sorttest.Run(t) }
+	func FuzzSorting(f *testing.F) { sorttest.Fuzz(f) }
+*/
+package sorttest
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"testing"
+
+	"algorithms/sorting"
+)
+
+// Shape generates an input of n ints with a particular order, random is only used by the shapes that need it
+type Shape struct {
+	Name     string
+	Generate func(random *rand.Rand, n int) []int
+}
+
+// Shapes are the inputs every algorithm is checked and benchmarked on
+var Shapes = []Shape{
+	{"Sorted", func(_ *rand.Rand, n int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = i
+		}
+		return s
+	}},
+	{"Reversed", func(_ *rand.Rand, n int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = n - i
+		}
+		return s
+	}},
+	{"Random", func(random *rand.Rand, n int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = random.IntN(2*n+1) - n
+		}
+		return s
+	}},
+	{"FewUnique", func(random *rand.Rand, n int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = random.IntN(4)
+		}
+		return s
+	}},
+	{"Wide", func(random *rand.Rand, n int) []int {
+		s := make([]int, n)
+		for i := range s {
+			s[i] = int(random.Uint64())
+		}
+		return s
+	}},
+}
+
+// Sizes are the input lengths Run checks, they cover the empty slice, the insertion sort cutoffs and the run length of Tim
+var Sizes = []int{0, 1, 2, 5, 17, 100, 1000}
+
+// record is a value sorted by key, index remembers its original position so stability can be checked
+type record struct {
+	key   int
+	index int
+}
+
+// compareKeys orders records by key only, so records with the same key are equal to the sorts
+func compareKeys(a, b record) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+// stableSorts returns every stable sort of records, the comparison sorts and the distribution sorts by key
+func stableSorts() []sorting.Algorithm[record] {
+	var sorts []sorting.Algorithm[record]
+	for _, algorithm := range sorting.AlgorithmsFunc(compareKeys) {
+		if algorithm.Stable {
+			sorts = append(sorts, algorithm)
+		}
+	}
+
+	return append(sorts,
+		sorting.Algorithm[record]{Name: "Counting", Stable: true, Sort: func(s []record) {
+			sorting.CountingFunc(s, func(r record) int { return r.key })
+		}},
+		sorting.Algorithm[record]{Name: "Radix", Stable: true, Sort: func(s []record) {
+			sorting.RadixFunc(s, func(r record) uint64 { return uint64(r.key) ^ 1<<63 })
+		}},
+		sorting.Algorithm[record]{Name: "RadixStrings", Stable: true, Sort: func(s []record) {
+			sorting.RadixStringsFunc(s, func(r record) string { return fmt.Sprintf("%016x", uint64(r.key)^1<<63) })
+		}},
+	)
+}
+
+// Run checks every algorithm on every shape and size, each combination as its own subtest
+func Run(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+	for _, shape := range Shapes {
+		for _, n := range Sizes {
+			input := shape.Generate(random, n)
+			t.Run(fmt.Sprintf("%s/%d", shape.Name, n), func(t *testing.T) {
+				Check(t, input)
+			})
+		}
+	}
+}
+
+// Fuzz checks every algorithm on inputs from the fuzzer, every byte is one signed value so duplicates are common
+func Fuzz(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{3, 1, 2})
+	f.Add([]byte{0x80, 0x7f, 0xff, 0, 1, 0x80})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		input := make([]int, len(data))
+		for i, b := range data {
+			input[i] = int(int8(b))
+		}
+		Check(t, input)
+	})
+}
+
+/*
+Check sorts a copy of input with every algorithm and fails t if the result differs from slices.Sort
+It then sorts input as records with every stable algorithm and fails t if records with equal keys changed order
+Radix strings is checked on the decimal strings of input against slices.Sort of the same strings
+*/
+func Check(t testing.TB, input []int) {
+	t.Helper()
+
+	want := slices.Clone(input)
+	slices.Sort(want)
+	for _, algorithm := range sorting.IntAlgorithms() {
+		got := slices.Clone(input)
+		algorithm.Sort(got)
+		if !slices.Equal(got, want) {
+			t.Fatalf("%s(%v): got %v, want %v", algorithm.Name, input, got, want)
+		}
+	}
+
+	words := make([]string, len(input))
+	for i, v := range input {
+		words[i] = strconv.Itoa(v)
+	}
+	wantWords := slices.Clone(words)
+	slices.Sort(wantWords)
+	sorting.RadixStrings(words)
+	if !slices.Equal(words, wantWords) {
+		t.Fatalf("RadixStrings(%v): got %v, want %v", input, words, wantWords)
+	}
+
+	records := make([]record, len(input))
+	for i, v := range input {
+		records[i] = record{key: v, index: i}
+	}
+	wantRecords := slices.Clone(records)
+	slices.SortStableFunc(wantRecords, compareKeys)
+	for _, algorithm := range stableSorts() {
+		got := slices.Clone(records)
+		algorithm.Sort(got)
+		if !slices.Equal(got, wantRecords) {
+			t.Fatalf("%s is not stable on %v: got %v, want %v", algorithm.Name, input, got, wantRecords)
+		}
+	}
+}
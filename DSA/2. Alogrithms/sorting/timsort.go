@@ -0,0 +1,139 @@
+package sorting
+
+import "cmp"
+
+// Tim sorts s in ascending order with timsort, see TimFunc
+func Tim[S ~[]E, E cmp.Ordered](s S) {
+	TimFunc(s, cmp.Compare[E])
+}
+
+// run is a sorted stretch of the slice being sorted by timsort
+type run struct {
+	start  int
+	length int
+}
+
+/*
+TimFunc sorts s with timsort, the adaptive merge sort used by Python and Java
+It scans s for runs that are already ascending (or strictly descending, which it reverses), extends short runs
+to a minimum length with binary insertion sort and pushes them on a stack
+Runs on the stack are merged while their lengths break the rules len(A) > len(B) + len(C) and len(B) > len(C),
+which keeps the merges balanced. Sorted or reversed input is one run and sorts in O(n)
+It is stable and runs in O(n log n) time with O(n) extra memory
+This version leaves out the galloping mode of the original, so it does a few more comparisons on very skewed merges
+*/
+func TimFunc[S ~[]E, E any](s S, compare func(a, b E) int) {
+	n := len(s)
+	if n < 2 {
+		return
+	}
+	if n < 64 {
+		binaryInsertion(s, countRun(s, 0, compare), compare)
+		return
+	}
+
+	minRun := minRunLength(n)
+	var runs []run
+	buffer := make([]E, 0, n/2)
+	for lo := 0; lo < n; {
+		length := countRun(s, lo, compare)
+		if length < minRun {
+			forced := min(minRun, n-lo)
+			binaryInsertion(s[lo:lo+forced], length, compare)
+			length = forced
+		}
+
+		runs = append(runs, run{start: lo, length: length})
+		runs = collapse(s, runs, &buffer, false, compare)
+		lo += length
+	}
+	collapse(s, runs, &buffer, true, compare)
+}
+
+/*
+minRunLength returns the minimum run length for n values: n is shifted down until it is below 64,
+adding 1 if any bit shifted out was set, so n / minRun is a power of 2 or just below one
+*/
+func minRunLength(n int) int {
+	extra := 0
+	for n >= 64 {
+		extra |= n & 1
+		n >>= 1
+	}
+	return n + extra
+}
+
+/*
+countRun returns the length of the run starting at lo
+A strictly descending run is reversed in place, strictness is needed so reversing never reorders equal values
+*/
+func countRun[S ~[]E, E any](s S, lo int, compare func(a, b E) int) int {
+	hi := lo + 1
+	if hi == len(s) {
+		return 1
+	}
+
+	if compare(s[hi], s[lo]) < 0 {
+		for hi++; hi < len(s) && compare(s[hi], s[hi-1]) < 0; hi++ {
+		}
+		for i, j := lo, hi-1; i < j; i, j = i+1, j-1 {
+			s[i], s[j] = s[j], s[i]
+		}
+	} else {
+		for hi++; hi < len(s) && compare(s[hi], s[hi-1]) >= 0; hi++ {
+		}
+	}
+	return hi - lo
+}
+
+/*
+binaryInsertion sorts s, whose first sorted values are already in order, with binary insertion sort
+Each new value finds its place with a binary search (after any equal values, to stay stable) and the values after it shift right
+*/
+func binaryInsertion[S ~[]E, E any](s S, sorted int, compare func(a, b E) int) {
+	for i := max(sorted, 1); i < len(s); i++ {
+		value := s[i]
+		lo, hi := 0, i
+		for lo < hi {
+			middle := lo + (hi-lo)/2
+			if compare(value, s[middle]) < 0 {
+				hi = middle
+			} else {
+				lo = middle + 1
+			}
+		}
+		copy(s[lo+1:i+1], s[lo:i])
+		s[lo] = value
+	}
+}
+
+/*
+collapse merges runs at the top of the stack until the length rules hold again and returns the shortened stack
+With force set it merges everything into one run, which finishes the sort
+*/
+func collapse[S ~[]E, E any](s S, runs []run, buffer *[]E, force bool, compare func(a, b E) int) []run {
+	for len(runs) > 1 {
+		n := len(runs) - 2
+		switch {
+		case force:
+			if n > 0 && runs[n-1].length < runs[n+1].length {
+				n--
+			}
+		case n > 0 && runs[n-1].length <= runs[n].length+runs[n+1].length,
+			n > 1 && runs[n-2].length <= runs[n-1].length+runs[n].length:
+			if runs[n-1].length < runs[n+1].length {
+				n--
+			}
+		case runs[n].length > runs[n+1].length:
+			return runs
+		}
+
+		left, right := runs[n], runs[n+1]
+		*buffer = append((*buffer)[:0], s[left.start:left.start+left.length]...)
+		merge(s[left.start:right.start+right.length], *buffer, s[right.start:right.start+right.length], compare)
+
+		runs[n].length += right.length
+		runs = append(runs[:n+1], runs[n+2:]...)
+	}
+	return runs
+}